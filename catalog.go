@@ -0,0 +1,272 @@
+package domainerror
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Locales com catálogo embutido. pt-BR é o idioma das definições dos erros
+const (
+	LocalePtBR    = "pt-BR"
+	LocaleEN      = "en"
+	LocaleES      = "es"
+	DefaultLocale = LocalePtBR
+)
+
+// Catalog guarda as mensagens e os templates traduzidos, indexados por locale
+// e código. Em DefaultLocale, códigos sem tradução usam a própria definição
+type Catalog struct {
+	mu        sync.RWMutex
+	messages  map[string]map[string]string
+	templates map[string]map[string]string
+}
+
+var defaultCatalog = NewCatalog().
+	Add(LocaleEN, messagesEN).
+	AddTemplates(LocaleEN, templatesEN).
+	Add(LocaleES, messagesES).
+	AddTemplates(LocaleES, templatesES)
+
+// NewCatalog cria um catálogo vazio, que conhece apenas DefaultLocale
+func NewCatalog() *Catalog {
+	return &Catalog{
+		messages:  make(map[string]map[string]string),
+		templates: make(map[string]map[string]string),
+	}
+}
+
+// Add mescla as mensagens (código → mensagem) ao locale informado
+func (c *Catalog) Add(locale string, messages map[string]string) *Catalog {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	mergeBundle(c.messages, locale, messages)
+	return c
+}
+
+// AddTemplates mescla os templates (código → template) ao locale informado.
+// Ver DomainError.WithTemplate
+func (c *Catalog) AddTemplates(locale string, templates map[string]string) *Catalog {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	mergeBundle(c.templates, locale, templates)
+	return c
+}
+
+func mergeBundle(bundles map[string]map[string]string, locale string, entries map[string]string) {
+	bundle, ok := bundles[locale]
+	if !ok {
+		bundle = make(map[string]string, len(entries))
+		bundles[locale] = bundle
+	}
+	for code, entry := range entries {
+		bundle[code] = entry
+	}
+}
+
+// Locales retorna os locales conhecidos, em ordem alfabética
+func (c *Catalog) Locales() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	locales := []string{DefaultLocale}
+	for _, bundles := range []map[string]map[string]string{c.messages, c.templates} {
+		for locale := range bundles {
+			if locale != DefaultLocale && !slices.Contains(locales, locale) {
+				locales = append(locales, locale)
+			}
+		}
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Match resolve uma language tag (ex: en-US, PT) para um locale conhecido:
+// primeiro a tag exata, depois o mesmo idioma base
+func (c *Catalog) Match(tag string) (string, bool) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return "", false
+	}
+
+	locales := c.Locales()
+	for _, locale := range locales {
+		if strings.EqualFold(locale, tag) {
+			return locale, true
+		}
+	}
+	for _, locale := range locales {
+		if strings.EqualFold(baseLanguage(locale), baseLanguage(tag)) {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+// Message retorna a mensagem do código no locale informado
+func (c *Catalog) Message(code, locale string) (string, bool) {
+	matched, ok := c.Match(locale)
+	if !ok {
+		return "", false
+	}
+
+	c.mu.RLock()
+	message, ok := c.messages[matched][code]
+	c.mu.RUnlock()
+	if ok {
+		return message, true
+	}
+
+	if matched == DefaultLocale {
+		if err, registered := Lookup(code); registered {
+			return err.Message(), true
+		}
+	}
+	return "", false
+}
+
+// Template retorna o template do código no locale informado
+func (c *Catalog) Template(code, locale string) (string, bool) {
+	matched, ok := c.Match(locale)
+	if !ok {
+		return "", false
+	}
+
+	c.mu.RLock()
+	template, ok := c.templates[matched][code]
+	c.mu.RUnlock()
+	if ok {
+		return template, true
+	}
+
+	if matched == DefaultLocale {
+		if err, registered := Lookup(code); registered && err.template != "" {
+			return err.template, true
+		}
+	}
+	return "", false
+}
+
+// Localize retorna a mensagem do erro no locale informado, já com os params
+// aplicados ao template. Mensagens customizadas com WithMessage não são
+// traduzidas, e códigos sem tradução mantêm a mensagem original. Erros que não
+// são de domínio são tratados como ErrInternalServer
+func (c *Catalog) Localize(err error, locale string) string {
+	if err == nil {
+		return ""
+	}
+
+	domainErr := ErrInternalServer
+	errors.As(err, &domainErr)
+	return c.localizeDomainError(domainErr, locale).Message()
+}
+
+// LocalizeError retorna uma cópia do erro de domínio com a mensagem no locale
+// informado. Em ValidationErrors, as mensagens das violações também são
+// traduzidas. Erros que não são de domínio viram ErrInternalServer localizado,
+// com o erro original como causa
+func (c *Catalog) LocalizeError(err error, locale string) error {
+	if err == nil {
+		return nil
+	}
+
+	var verrs *ValidationErrors
+	if errors.As(err, &verrs) {
+		localized := &ValidationErrors{
+			invalidInput: c.localizeDomainError(verrs.base(), locale),
+		}
+		for _, v := range verrs.violations {
+			v.Message = c.translateViolation(v, locale)
+			localized.violations = append(localized.violations, v)
+		}
+		return localized
+	}
+
+	var domainErr *DomainError
+	if !errors.As(err, &domainErr) {
+		return c.localizeDomainError(ErrInternalServer, locale).WithCause(err)
+	}
+	return c.localizeDomainError(domainErr, locale)
+}
+
+func (c *Catalog) localizeDomainError(err *DomainError, locale string) *DomainError {
+	message, hasMessage := c.Message(err.code, locale)
+	template, hasTemplate := c.Template(err.code, locale)
+	if !hasMessage && !hasTemplate {
+		return err
+	}
+
+	localized := err.clone()
+	if hasMessage {
+		localized.title = message
+	}
+
+	registered, ok := Lookup(err.code)
+	if ok && (registered.message != err.message || registered.template != err.template) {
+		// mensagem customizada com WithMessage ou WithTemplate: só o título é traduzido
+		return localized
+	}
+
+	if hasMessage {
+		localized.message = message
+	}
+	if hasTemplate {
+		localized.template = template
+	}
+	return localized
+}
+
+// translateViolation traduz a mensagem da violação quando ela é a mensagem
+// registrada para o código, renderizada com os params da violação
+func (c *Catalog) translateViolation(v Violation, locale string) string {
+	registered, ok := Lookup(v.Code)
+	if !ok {
+		if translated, found := c.Message(v.Code, locale); found {
+			return translated
+		}
+		return v.Message
+	}
+
+	withParams := registered.WithParams(v.Params)
+	if withParams.Message() != v.Message {
+		return v.Message
+	}
+	return c.localizeDomainError(withParams, locale).Message()
+}
+
+func baseLanguage(tag string) string {
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		return tag[:i]
+	}
+	return tag
+}
+
+// DefaultCatalog retorna o catálogo padrão, com pt-BR, en e es
+func DefaultCatalog() *Catalog {
+	return defaultCatalog
+}
+
+// RegisterTemplates adiciona templates traduzidos ao catálogo padrão
+func RegisterTemplates(locale string, templates map[string]string) {
+	defaultCatalog.AddTemplates(locale, templates)
+}
+
+// RegisterMessages adiciona traduções ao catálogo padrão, para novos locales
+// ou para os códigos registrados pelo consumidor
+func RegisterMessages(locale string, messages map[string]string) {
+	defaultCatalog.Add(locale, messages)
+}
+
+// Localize retorna a mensagem do erro no locale informado usando o catálogo padrão
+func Localize(err error, locale string) string {
+	return defaultCatalog.Localize(err, locale)
+}
+
+// LocalizeError traduz o erro para o locale informado usando o catálogo padrão
+func LocalizeError(err error, locale string) error {
+	return defaultCatalog.LocalizeError(err, locale)
+}
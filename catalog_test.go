@@ -0,0 +1,111 @@
+package domainerror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCatalog_EveryErrorIsTranslated(t *testing.T) {
+	for _, locale := range []string{LocaleEN, LocaleES} {
+		for _, err := range All() {
+			if _, ok := defaultCatalog.messages[locale][err.Code()]; !ok {
+				t.Errorf("%s has no %s message", err.Code(), locale)
+			}
+		}
+	}
+}
+
+func TestCatalog_Match(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected string
+		ok       bool
+	}{
+		{tag: "pt-BR", expected: LocalePtBR, ok: true},
+		{tag: "pt-br", expected: LocalePtBR, ok: true},
+		{tag: "pt", expected: LocalePtBR, ok: true},
+		{tag: "en-US", expected: LocaleEN, ok: true},
+		{tag: "es-AR", expected: LocaleES, ok: true},
+		{tag: "fr-FR", expected: "", ok: false},
+		{tag: "", expected: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, ok := DefaultCatalog().Match(tt.tag)
+			if got != tt.expected || ok != tt.ok {
+				t.Errorf("Match() = %v, %v, want %v, %v", got, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		locale   string
+		expected string
+	}{
+		{name: "Portuguese", err: ErrNotFound, locale: LocalePtBR, expected: "Registro não encontrado"},
+		{name: "English", err: ErrNotFound, locale: "en-US", expected: "Record not found"},
+		{name: "Spanish", err: ErrNotFound, locale: LocaleES, expected: "Registro no encontrado"},
+		{name: "Wrapped", err: fmt.Errorf("get: %w", Wrap(ErrDuplicateEmail, errors.New("23505"))), locale: LocaleEN, expected: "Email already registered"},
+		{name: "Unknown locale keeps original", err: ErrNotFound, locale: "fr", expected: "Registro não encontrado"},
+		{name: "Custom message is kept", err: ErrNotFound.WithMessage("Cliente não encontrado"), locale: LocaleEN, expected: "Cliente não encontrado"},
+		{name: "Unregistered code keeps original", err: New("UNREGISTERED", "Sem tradução"), locale: LocaleEN, expected: "Sem tradução"},
+		{name: "Non domain error", err: errors.New("boom"), locale: LocaleEN, expected: "Internal server error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Localize(tt.err, tt.locale); got != tt.expected {
+				t.Errorf("Localize() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCatalog_ConsumerMessages(t *testing.T) {
+	catalog := NewCatalog().Add("en", map[string]string{"CONTRACT_SIGNED": "Contract already signed"})
+	signed := New("CONTRACT_SIGNED", "Contrato já assinado")
+
+	if got := catalog.Localize(signed, "en"); got != "Contract already signed" {
+		t.Errorf("Localize() = %v, want Contract already signed", got)
+	}
+
+	if got := catalog.Localize(ErrNotFound, "en"); got != "Registro não encontrado" {
+		t.Errorf("Localize() without bundle = %v, want original message", got)
+	}
+}
+
+func TestLocalizeError(t *testing.T) {
+	cause := errors.New("23505")
+	localized := LocalizeError(Wrap(ErrDuplicateEmail, cause), LocaleEN)
+
+	if !errors.Is(localized, ErrConflict) || !errors.Is(localized, cause) {
+		t.Errorf("LocalizeError() = %v, want ErrConflict with cause", localized)
+	}
+
+	verrs := NewValidationErrors().
+		AddError("/phone", ErrInvalidPhone).
+		Add("/name", "NAME_TOO_SHORT", "Nome muito curto", nil)
+
+	body, err := json.Marshal(LocalizeError(verrs, LocaleES))
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	expected := `{"code":"INVALID_INPUT","message":"Entrada inválida","violations":[` +
+		`{"path":"/phone","code":"INVALID_PHONE","message":"Teléfono inválido"},` +
+		`{"path":"/name","code":"NAME_TOO_SHORT","message":"Nome muito curto"}]}`
+	if string(body) != expected {
+		t.Errorf("json.Marshal() = %s, want %s", body, expected)
+	}
+
+	if len(verrs.Violations()) != 2 || verrs.base() != ErrInvalidInput {
+		t.Errorf("LocalizeError() changed the original ValidationErrors")
+	}
+}
@@ -0,0 +1,57 @@
+package domainerror
+
+import "net/http"
+
+// Category agrupa os erros de domínio pela natureza da falha. Um erro sem
+// status HTTP declarado responde com o status padrão da sua categoria
+type Category int
+
+const (
+	CategoryUnknown Category = iota
+	CategoryValidation
+	CategoryAuthentication
+	CategoryAuthorization
+	CategoryNotFound
+	CategoryConflict
+	CategoryBusinessRule
+	CategoryRateLimit
+	CategoryExternal
+	CategoryInternal
+)
+
+var categoryNames = map[Category]string{
+	CategoryUnknown:        "unknown",
+	CategoryValidation:     "validation",
+	CategoryAuthentication: "authentication",
+	CategoryAuthorization:  "authorization",
+	CategoryNotFound:       "not_found",
+	CategoryConflict:       "conflict",
+	CategoryBusinessRule:   "business_rule",
+	CategoryRateLimit:      "rate_limit",
+	CategoryExternal:       "external",
+	CategoryInternal:       "internal",
+}
+
+var categoryStatus = map[Category]int{
+	CategoryValidation:     http.StatusBadRequest,
+	CategoryAuthentication: http.StatusUnauthorized,
+	CategoryAuthorization:  http.StatusForbidden,
+	CategoryNotFound:       http.StatusNotFound,
+	CategoryConflict:       http.StatusConflict,
+	CategoryBusinessRule:   http.StatusUnprocessableEntity,
+	CategoryRateLimit:      http.StatusTooManyRequests,
+	CategoryExternal:       http.StatusBadGateway,
+	CategoryInternal:       http.StatusInternalServerError,
+}
+
+func (c Category) String() string {
+	if name, ok := categoryNames[c]; ok {
+		return name
+	}
+	return categoryNames[CategoryUnknown]
+}
+
+// HTTPStatus retorna o status HTTP padrão da categoria; 0 para CategoryUnknown
+func (c Category) HTTPStatus() int {
+	return categoryStatus[c]
+}
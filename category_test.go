@@ -0,0 +1,108 @@
+package domainerror
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCategory_HTTPStatus(t *testing.T) {
+	tests := []struct {
+		category Category
+		expected int
+	}{
+		{category: CategoryValidation, expected: http.StatusBadRequest},
+		{category: CategoryAuthentication, expected: http.StatusUnauthorized},
+		{category: CategoryAuthorization, expected: http.StatusForbidden},
+		{category: CategoryNotFound, expected: http.StatusNotFound},
+		{category: CategoryConflict, expected: http.StatusConflict},
+		{category: CategoryBusinessRule, expected: http.StatusUnprocessableEntity},
+		{category: CategoryRateLimit, expected: http.StatusTooManyRequests},
+		{category: CategoryExternal, expected: http.StatusBadGateway},
+		{category: CategoryInternal, expected: http.StatusInternalServerError},
+		{category: CategoryUnknown, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.category.String(), func(t *testing.T) {
+			if got := tt.category.HTTPStatus(); got != tt.expected {
+				t.Errorf("HTTPStatus() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCategory_FallbackStatus(t *testing.T) {
+	mapper := NewHTTPStatusMapper()
+
+	tests := []struct {
+		name     string
+		err      *DomainError
+		expected int
+	}{
+		{
+			name:     "Business rule without status",
+			err:      NewWithCategory("CONTRACT_SIGNED", "Contrato já assinado", CategoryBusinessRule),
+			expected: http.StatusUnprocessableEntity,
+		},
+		{
+			name:     "Rate limit without status",
+			err:      NewWithCategory("EXPORT_THROTTLED", "Exportação limitada", CategoryRateLimit),
+			expected: http.StatusTooManyRequests,
+		},
+		{
+			name:     "Child inherits category",
+			err:      NewWithCategory("PARTNER_DOWN", "Parceiro fora do ar", CategoryExternal).Child("PARTNER_TIMEOUT", "Parceiro não respondeu"),
+			expected: http.StatusBadGateway,
+		},
+		{
+			name:     "Declared status wins over category",
+			err:      ErrRecordLocked,
+			expected: http.StatusLocked,
+		},
+		{
+			name:     "No status and no category",
+			err:      New("UNMAPPED", "Sem mapeamento"),
+			expected: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapper.GetHTTPStatus(tt.err); got != tt.expected {
+				t.Errorf("GetHTTPStatus() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCategory_BuiltInErrors(t *testing.T) {
+	tests := []struct {
+		err      *DomainError
+		expected Category
+	}{
+		{err: ErrInvalidInput, expected: CategoryValidation},
+		{err: ErrInvalidEmail, expected: CategoryValidation},
+		{err: ErrUnauthorized, expected: CategoryAuthentication},
+		{err: ErrInsufficientPermissions, expected: CategoryAuthorization},
+		{err: ErrFileNotFound, expected: CategoryNotFound},
+		{err: ErrDuplicateLead, expected: CategoryConflict},
+		{err: ErrInsufficientBalance, expected: CategoryBusinessRule},
+		{err: ErrQuotaExceeded, expected: CategoryRateLimit},
+		{err: ErrExternalServiceTimeout, expected: CategoryExternal},
+		{err: ErrDatabaseQuery, expected: CategoryInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Code(), func(t *testing.T) {
+			if got := tt.err.Category(); got != tt.expected {
+				t.Errorf("Category() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	for _, err := range All() {
+		if err.Category() == CategoryUnknown {
+			t.Errorf("%s has no category", err.Code())
+		}
+	}
+}
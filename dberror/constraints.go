@@ -0,0 +1,88 @@
+package dberror
+
+import (
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+// Constraints associa as constraints do schema a erros de domínio: unique,
+// foreign key e check pelo nome da constraint, e NOT NULL pela tabela e coluna
+type Constraints struct {
+	byName  map[string]*domainerror.DomainError
+	notNull map[string]*domainerror.DomainError
+}
+
+// NewConstraints cria uma configuração vazia; violações sem mapeamento usam o
+// erro padrão de cada tipo (ErrConflict, ErrInvalidRelationship etc.)
+func NewConstraints() *Constraints {
+	return &Constraints{
+		byName:  make(map[string]*domainerror.DomainError),
+		notNull: make(map[string]*domainerror.DomainError),
+	}
+}
+
+// constraintsFromMap converte o mapa nome → erro aceito pelos construtores
+// originais dos mappers
+func constraintsFromMap(errorsByName map[string]*domainerror.DomainError) *Constraints {
+	constraints := NewConstraints()
+	for name, err := range errorsByName {
+		constraints.Constraint(name, err)
+	}
+	return constraints
+}
+
+// Constraint associa uma constraint unique, foreign key ou check, pelo nome
+// (ex: uk_customer_email, fk_contract_customer), a um erro de domínio
+func (c *Constraints) Constraint(name string, err *domainerror.DomainError) *Constraints {
+	if err != nil {
+		c.byName[name] = err
+	}
+	return c
+}
+
+// NotNull associa a coluna NOT NULL a um erro de domínio. Com table vazio, vale
+// para a coluna em qualquer tabela; no MySQL, cuja mensagem não informa a
+// tabela, apenas esse formato é usado
+func (c *Constraints) NotNull(table, column string, err *domainerror.DomainError) *Constraints {
+	if err != nil {
+		c.notNull[notNullKey(table, column)] = err
+	}
+	return c
+}
+
+func (c *Constraints) constraintError(name string) (*domainerror.DomainError, bool) {
+	if c == nil || name == "" {
+		return nil, false
+	}
+	err, ok := c.byName[name]
+	return err, ok
+}
+
+// notNullError procura primeiro a tabela e coluna, depois só a coluna
+func (c *Constraints) notNullError(table, column string) (*domainerror.DomainError, bool) {
+	if c == nil || column == "" {
+		return nil, false
+	}
+	if table != "" {
+		if err, ok := c.notNull[notNullKey(table, column)]; ok {
+			return err, true
+		}
+	}
+	err, ok := c.notNull[notNullKey("", column)]
+	return err, ok
+}
+
+func notNullKey(table, column string) string {
+	if table == "" {
+		return column
+	}
+	return table + "." + column
+}
+
+// requiredField retorna ErrRequiredField com o campo preenchido quando a
+// coluna é conhecida
+func requiredField(column string) *domainerror.DomainError {
+	if column == "" {
+		return domainerror.ErrRequiredField
+	}
+	return domainerror.ErrRequiredField.WithField(column)
+}
@@ -1,5 +1,40 @@
 package dberror
 
+import (
+	"database/sql"
+	"errors"
+
+	domainerror "github.com/renatofagalde/module-error"
+	"gorm.io/gorm"
+)
+
 type DBErrorMapper interface {
 	Map(err error) error
 }
+
+// sentinelError converte os erros sentinela do gorm e do database/sql, que não
+// dependem do dialeto. Com TranslateError do gorm ligado, os erros de constraint
+// chegam apenas como sentinela, sem o nome da constraint
+func sentinelError(err error) (*domainerror.DomainError, bool) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, sql.ErrNoRows):
+		return domainerror.ErrNotFound, true
+
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return domainerror.ErrConflict, true
+
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return domainerror.ErrInvalidRelationship, true
+
+	case errors.Is(err, gorm.ErrCheckConstraintViolated):
+		return domainerror.ErrInvalidInput, true
+
+	// transação já finalizada ou inválida: erro de programação, não do cliente
+	case errors.Is(err, gorm.ErrInvalidTransaction), errors.Is(err, sql.ErrTxDone):
+		return domainerror.ErrDatabaseQuery, true
+
+	case errors.Is(err, sql.ErrConnDone):
+		return domainerror.ErrDatabaseConnection, true
+	}
+	return nil, false
+}
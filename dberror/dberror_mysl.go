@@ -1,6 +1,7 @@
 package dberror
 
 import (
+	"database/sql/driver"
 	"errors"
 	"strings"
 
@@ -9,12 +10,20 @@ import (
 )
 
 type MySQLErrorMapper struct {
-	duplicateIndexErrors map[string]*domainerror.DomainError
+	constraints *Constraints
 }
 
+// NewMySQLErrorMapper cria o mapper com erros por nome de índice unique ou de
+// constraint (foreign key ou check)
 func NewMySQLErrorMapper(duplicateIndexErrors map[string]*domainerror.DomainError) DBErrorMapper {
+	return NewMySQLErrorMapperWithConstraints(constraintsFromMap(duplicateIndexErrors))
+}
+
+// NewMySQLErrorMapperWithConstraints cria o mapper com a configuração completa
+// de constraints, incluindo colunas NOT NULL
+func NewMySQLErrorMapperWithConstraints(constraints *Constraints) DBErrorMapper {
 	return &MySQLErrorMapper{
-		duplicateIndexErrors: duplicateIndexErrors,
+		constraints: constraints,
 	}
 }
 
@@ -23,28 +32,145 @@ func (m *MySQLErrorMapper) Map(err error) error {
 		return nil
 	}
 
+	if errors.Is(err, mysql.ErrInvalidConn) || errors.Is(err, driver.ErrBadConn) {
+		return domainerror.Wrap(domainerror.ErrDatabaseConnection, err)
+	}
+
 	var mysqlErr *mysql.MySQLError
 	if errors.As(err, &mysqlErr) {
 		switch mysqlErr.Number {
 
 		// Duplicate entry
 		case 1062:
-			msg := mysqlErr.Message
-
-			if m.duplicateIndexErrors != nil {
-				for indexName, derr := range m.duplicateIndexErrors {
-					if derr != nil && strings.Contains(msg, indexName) {
-						return derr
-					}
-				}
+			if derr, ok := m.duplicateKeyError(mysqlErr.Message); ok {
+				return domainerror.Wrap(derr, err)
 			}
+			return domainerror.Wrap(domainerror.ErrConflict, err)
 
-			return domainerror.ErrConflict
-
+		// Column cannot be null
 		case 1048:
-			return domainerror.ErrRequiredField
+			column, _ := quotedAfter(mysqlErr.Message, "Column ")
+			if derr, ok := m.constraints.notNullError("", column); ok {
+				return domainerror.Wrap(derr, err)
+			}
+			return domainerror.Wrap(requiredField(column), err)
+
+		// Cannot delete or update a parent row: existem registros filhos
+		case 1451:
+			if derr, ok := m.constraintError(mysqlErr.Message, "CONSTRAINT "); ok {
+				return domainerror.Wrap(derr, err)
+			}
+			return domainerror.Wrap(domainerror.ErrRecordInUse, err)
+
+		// Cannot add or update a child row: o registro pai não existe
+		case 1452:
+			if derr, ok := m.constraintError(mysqlErr.Message, "CONSTRAINT "); ok {
+				return domainerror.Wrap(derr, err)
+			}
+			return domainerror.Wrap(domainerror.ErrInvalidRelationship, err)
+
+		// Data too long e Out of range value: o campo vem da coluna da mensagem
+		case 1406, 1264:
+			derr := domainerror.ErrInvalidInput
+			if column, ok := quotedAfter(mysqlErr.Message, "for column "); ok {
+				derr = derr.WithField(column)
+			}
+			return domainerror.Wrap(derr, err)
+
+		// Check constraint is violated (MySQL 8.0.16+)
+		case 3819:
+			if derr, ok := m.constraintError(mysqlErr.Message, "Check constraint "); ok {
+				return domainerror.Wrap(derr, err)
+			}
+			return domainerror.Wrap(domainerror.ErrInvalidInput, err)
+
+		// Lock wait timeout exceeded: o lock pode ser liberado e a transação repetida
+		case 1205:
+			return domainerror.Wrap(domainerror.ErrLockWaitTimeout, err)
+
+		// Deadlock found when trying to get lock: a transação pode ser repetida
+		case 1213:
+			return domainerror.Wrap(domainerror.ErrDeadlockDetected, err)
+
+		// Too many connections
+		case 1040:
+			return domainerror.Wrap(domainerror.ErrDatabaseConnection, err)
 		}
 	}
 
-	return domainerror.ErrDatabaseQuery
+	if derr, ok := sentinelError(err); ok {
+		return domainerror.Wrap(derr, err)
+	}
+
+	return domainerror.Wrap(domainerror.ErrDatabaseQuery, err)
+}
+
+// duplicateKeyError procura o erro do índice da mensagem de 1062. O nome é
+// "índice" no MySQL 5.7 e "tabela.índice" a partir do 8.0.19; a configuração
+// pode usar qualquer uma das formas e, se ambas existirem, vale a mais longa
+func (m *MySQLErrorMapper) duplicateKeyError(msg string) (*domainerror.DomainError, bool) {
+	key, ok := duplicateKeyName(msg)
+	if !ok || m.constraints == nil {
+		return nil, false
+	}
+
+	_, index, qualified := strings.Cut(key, ".")
+
+	var (
+		matched string
+		derr    *domainerror.DomainError
+	)
+	for name, candidate := range m.constraints.byName {
+		if name != key && (!qualified || name != index) {
+			continue
+		}
+		if len(name) > len(matched) {
+			matched, derr = name, candidate
+		}
+	}
+	return derr, derr != nil
+}
+
+// duplicateKeyName extrai o nome do índice de "Duplicate entry '...' for key
+// '...'". Procura a última ocorrência, já que o valor duplicado pode conter o
+// mesmo texto
+func duplicateKeyName(msg string) (string, bool) {
+	const prefix = " for key '"
+
+	start := strings.LastIndex(msg, prefix)
+	if start < 0 {
+		return "", false
+	}
+
+	key, closed := strings.CutSuffix(msg[start+len(prefix):], "'")
+	return key, closed && key != ""
+}
+
+// constraintError procura o erro da constraint cujo nome aparece na mensagem
+// logo após prefix
+func (m *MySQLErrorMapper) constraintError(msg, prefix string) (*domainerror.DomainError, bool) {
+	name, ok := quotedAfter(msg, prefix)
+	if !ok {
+		return nil, false
+	}
+	return m.constraints.constraintError(name)
+}
+
+// quotedAfter retorna o texto entre aspas simples ou crases logo após prefix, ex:
+// quotedAfter("Data too long for column 'name' at row 1", "for column ") = "name"
+func quotedAfter(msg, prefix string) (string, bool) {
+	start := strings.Index(msg, prefix)
+	if start < 0 {
+		return "", false
+	}
+	rest := msg[start+len(prefix):]
+	if rest == "" || (rest[0] != '\'' && rest[0] != '`') {
+		return "", false
+	}
+
+	end := strings.IndexByte(rest[1:], rest[0])
+	if end < 0 {
+		return "", false
+	}
+	return rest[1 : end+1], true
 }
@@ -3,19 +3,27 @@ package dberror
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/jackc/pgx/v5/pgconn"
 	domainerror "github.com/renatofagalde/module-error"
-	"gorm.io/gorm"
 )
 
 type PostgresErrorMapper struct {
-	constraintErrors map[string]*domainerror.DomainError
+	constraints *Constraints
 }
 
+// NewPostgresErrorMapper cria o mapper com erros por nome de constraint
+// (unique, foreign key ou check)
 func NewPostgresErrorMapper(constraintErrors map[string]*domainerror.DomainError) DBErrorMapper {
+	return NewPostgresErrorMapperWithConstraints(constraintsFromMap(constraintErrors))
+}
+
+// NewPostgresErrorMapperWithConstraints cria o mapper com a configuração
+// completa de constraints, incluindo colunas NOT NULL
+func NewPostgresErrorMapperWithConstraints(constraints *Constraints) DBErrorMapper {
 	return &PostgresErrorMapper{
-		constraintErrors: constraintErrors,
+		constraints: constraints,
 	}
 }
 
@@ -24,33 +32,77 @@ func (m *PostgresErrorMapper) Map(err error) error {
 		return nil
 	}
 
-	if errors.Is(err, gorm.ErrDuplicatedKey) {
-		return domainerror.ErrConflict
-	}
-
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
 		switch pgErr.Code {
+		// unique_violation
 		case "23505":
-			if m.constraintErrors != nil {
-				if derr, ok := m.constraintErrors[pgErr.ConstraintName]; ok && derr != nil {
-					return derr
-				}
+			if derr, ok := m.constraints.constraintError(pgErr.ConstraintName); ok {
+				return domainerror.Wrap(derr, err)
 			}
-			return domainerror.ErrConflict
+			return domainerror.Wrap(domainerror.ErrConflict, err)
 
+		// foreign_key_violation
 		case "23503":
-			return domainerror.ErrInvalidRelationship
+			if derr, ok := m.constraints.constraintError(pgErr.ConstraintName); ok {
+				return domainerror.Wrap(derr, err)
+			}
+			return domainerror.Wrap(domainerror.ErrInvalidRelationship, err)
 
+		// not_null_violation
 		case "23502":
-			return domainerror.ErrRequiredField
+			if derr, ok := m.constraints.notNullError(pgErr.TableName, pgErr.ColumnName); ok {
+				return domainerror.Wrap(derr, err)
+			}
+			return domainerror.Wrap(requiredField(pgErr.ColumnName), err)
+
+		// check_violation
+		case "23514":
+			if derr, ok := m.constraints.constraintError(pgErr.ConstraintName); ok {
+				return domainerror.Wrap(derr, err)
+			}
+			return domainerror.Wrap(domainerror.ErrInvalidInput, err)
+
+		// string_data_right_truncation e invalid_text_representation
+		case "22001", "22P02":
+			return domainerror.Wrap(domainerror.ErrInvalidInput, err)
+
+		// serialization_failure e deadlock_detected: a transação pode ser repetida
+		case "40001":
+			return domainerror.Wrap(domainerror.ErrSerializationFailure, err)
+
+		case "40P01":
+			return domainerror.Wrap(domainerror.ErrDeadlockDetected, err)
+
+		// lock_not_available (SELECT ... FOR UPDATE NOWAIT, lock_timeout): o lock
+		// do banco é liberado ao fim da outra transação, então pode ser repetido
+		case "55P03":
+			return domainerror.Wrap(domainerror.ErrRecordLocked.WithRetryable(true), err)
+
+		// query_canceled (statement_timeout ou pg_cancel_backend): falha do lado
+		// do servidor, não do cliente
+		case "57014":
+			return domainerror.Wrap(domainerror.ErrDatabaseTimeout, err)
+
+		// too_many_connections
+		case "53300":
+			return domainerror.Wrap(domainerror.ErrDatabaseConnection, err)
 		}
+
+		// classe 08: connection exception
+		if strings.HasPrefix(pgErr.Code, "08") {
+			return domainerror.Wrap(domainerror.ErrDatabaseConnection, err)
+		}
+	}
+
+	if derr, ok := sentinelError(err); ok {
+		return domainerror.Wrap(derr, err)
 	}
 
 	if errors.Is(err, context.DeadlineExceeded) ||
 		errors.Is(err, context.Canceled) {
-		return domainerror.ErrRequestTimeout
+		return domainerror.Wrap(domainerror.ErrRequestTimeout, err)
 	}
 
-	return domainerror.ErrDatabaseQuery
+	return domainerror.Wrap(domainerror.ErrDatabaseQuery, err)
 }
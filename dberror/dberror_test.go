@@ -0,0 +1,541 @@
+package dberror
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	mysql "github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	domainerror "github.com/renatofagalde/module-error"
+	"gorm.io/gorm"
+)
+
+func TestPostgresErrorMapper_MapKeepsCause(t *testing.T) {
+	mapper := NewPostgresErrorMapper(map[string]*domainerror.DomainError{
+		"uk_customer_email": domainerror.ErrDuplicateEmail,
+	})
+
+	tests := []struct {
+		name     string
+		err      error
+		expected *domainerror.DomainError
+	}{
+		{
+			name:     "Unique constraint mapped by name",
+			err:      &pgconn.PgError{Code: "23505", ConstraintName: "uk_customer_email"},
+			expected: domainerror.ErrDuplicateEmail,
+		},
+		{
+			name:     "Unmapped unique constraint",
+			err:      &pgconn.PgError{Code: "23505", ConstraintName: "uk_other"},
+			expected: domainerror.ErrConflict,
+		},
+		{
+			name:     "Foreign key violation",
+			err:      &pgconn.PgError{Code: "23503", ConstraintName: "fk_contract_customer"},
+			expected: domainerror.ErrInvalidRelationship,
+		},
+		{
+			name:     "Not null violation",
+			err:      &pgconn.PgError{Code: "23502", ColumnName: "name"},
+			expected: domainerror.ErrRequiredField,
+		},
+		{
+			name:     "Check violation",
+			err:      &pgconn.PgError{Code: "23514", ConstraintName: "ck_contract_value_positive"},
+			expected: domainerror.ErrInvalidInput,
+		},
+		{
+			name:     "String too long",
+			err:      &pgconn.PgError{Code: "22001", Message: "value too long for type character varying(20)"},
+			expected: domainerror.ErrInvalidInput,
+		},
+		{
+			name:     "Invalid text representation",
+			err:      &pgconn.PgError{Code: "22P02", Message: "invalid input syntax for type uuid"},
+			expected: domainerror.ErrInvalidInput,
+		},
+		{
+			name:     "Serialization failure",
+			err:      &pgconn.PgError{Code: "40001"},
+			expected: domainerror.ErrSerializationFailure,
+		},
+		{
+			name:     "Serialization failure is a concurrent modification",
+			err:      &pgconn.PgError{Code: "40001"},
+			expected: domainerror.ErrConcurrentModification,
+		},
+		{
+			name:     "Deadlock detected",
+			err:      &pgconn.PgError{Code: "40P01"},
+			expected: domainerror.ErrDeadlockDetected,
+		},
+		{
+			name:     "Lock not available",
+			err:      &pgconn.PgError{Code: "55P03"},
+			expected: domainerror.ErrRecordLocked,
+		},
+		{
+			name:     "Query canceled",
+			err:      &pgconn.PgError{Code: "57014", Message: "canceling statement due to statement timeout"},
+			expected: domainerror.ErrDatabaseTimeout,
+		},
+		{
+			name:     "Connection failure",
+			err:      &pgconn.PgError{Code: "08006"},
+			expected: domainerror.ErrDatabaseConnection,
+		},
+		{
+			name:     "Connection does not exist",
+			err:      &pgconn.PgError{Code: "08003"},
+			expected: domainerror.ErrDatabaseConnection,
+		},
+		{
+			name:     "Too many connections",
+			err:      &pgconn.PgError{Code: "53300"},
+			expected: domainerror.ErrDatabaseConnection,
+		},
+		{
+			name:     "Unknown SQLSTATE",
+			err:      &pgconn.PgError{Code: "42601"},
+			expected: domainerror.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapper.Map(tt.err)
+
+			if !errors.Is(got, tt.expected) {
+				t.Errorf("Map() = %v, want %v", got, tt.expected)
+			}
+
+			var pgErr *pgconn.PgError
+			if !errors.As(got, &pgErr) {
+				t.Errorf("errors.As(Map(), *pgconn.PgError) = false, want true")
+			}
+		})
+	}
+}
+
+func TestMySQLErrorMapper_MapKeepsCause(t *testing.T) {
+	mapper := NewMySQLErrorMapper(map[string]*domainerror.DomainError{
+		"uk_customer_email": domainerror.ErrDuplicateEmail,
+	})
+
+	tests := []struct {
+		name     string
+		err      error
+		expected *domainerror.DomainError
+	}{
+		{
+			name:     "Duplicate entry mapped by index",
+			err:      &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'a@b.com' for key 'uk_customer_email'"},
+			expected: domainerror.ErrDuplicateEmail,
+		},
+		{
+			name:     "Column cannot be null",
+			err:      &mysql.MySQLError{Number: 1048, Message: "Column 'name' cannot be null"},
+			expected: domainerror.ErrRequiredField,
+		},
+		{
+			name:     "Deadlock",
+			err:      &mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock; try restarting transaction"},
+			expected: domainerror.ErrDeadlockDetected,
+		},
+		{
+			name:     "Parent row in use",
+			err:      &mysql.MySQLError{Number: 1451, Message: "Cannot delete or update a parent row: a foreign key constraint fails"},
+			expected: domainerror.ErrRecordInUse,
+		},
+		{
+			name:     "Child row without parent",
+			err:      &mysql.MySQLError{Number: 1452, Message: "Cannot add or update a child row: a foreign key constraint fails"},
+			expected: domainerror.ErrInvalidRelationship,
+		},
+		{
+			name:     "Data too long",
+			err:      &mysql.MySQLError{Number: 1406, Message: "Data too long for column 'name' at row 1"},
+			expected: domainerror.ErrInvalidInput,
+		},
+		{
+			name:     "Out of range",
+			err:      &mysql.MySQLError{Number: 1264, Message: "Out of range value for column 'age' at row 1"},
+			expected: domainerror.ErrInvalidInput,
+		},
+		{
+			name:     "Check constraint",
+			err:      &mysql.MySQLError{Number: 3819, Message: "Check constraint 'ck_contract_value_positive' is violated."},
+			expected: domainerror.ErrInvalidInput,
+		},
+		{
+			name:     "Lock wait timeout",
+			err:      &mysql.MySQLError{Number: 1205, Message: "Lock wait timeout exceeded; try restarting transaction"},
+			expected: domainerror.ErrLockWaitTimeout,
+		},
+		{
+			name:     "Lock wait timeout is a concurrent modification",
+			err:      &mysql.MySQLError{Number: 1205, Message: "Lock wait timeout exceeded; try restarting transaction"},
+			expected: domainerror.ErrConcurrentModification,
+		},
+		{
+			name:     "Deadlock is a concurrent modification",
+			err:      &mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock; try restarting transaction"},
+			expected: domainerror.ErrConcurrentModification,
+		},
+		{
+			name:     "Too many connections",
+			err:      &mysql.MySQLError{Number: 1040, Message: "Too many connections"},
+			expected: domainerror.ErrDatabaseConnection,
+		},
+		{
+			name:     "Unknown error number",
+			err:      &mysql.MySQLError{Number: 1064, Message: "You have an error in your SQL syntax"},
+			expected: domainerror.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapper.Map(tt.err)
+
+			if !errors.Is(got, tt.expected) {
+				t.Errorf("Map() = %v, want %v", got, tt.expected)
+			}
+
+			var mysqlErr *mysql.MySQLError
+			if !errors.As(got, &mysqlErr) {
+				t.Errorf("errors.As(Map(), *mysql.MySQLError) = false, want true")
+			}
+		})
+	}
+}
+
+func TestErrorMapper_TransientErrorsAreRetryable(t *testing.T) {
+	tests := []struct {
+		name   string
+		mapper DBErrorMapper
+		err    error
+	}{
+		{name: "Postgres serialization failure", mapper: NewPostgresErrorMapper(nil), err: &pgconn.PgError{Code: "40001"}},
+		{name: "Postgres deadlock", mapper: NewPostgresErrorMapper(nil), err: &pgconn.PgError{Code: "40P01"}},
+		{name: "Postgres lock not available", mapper: NewPostgresErrorMapper(nil), err: &pgconn.PgError{Code: "55P03"}},
+		{name: "Postgres too many connections", mapper: NewPostgresErrorMapper(nil), err: &pgconn.PgError{Code: "53300"}},
+		{name: "MySQL deadlock", mapper: NewMySQLErrorMapper(nil), err: &mysql.MySQLError{Number: 1213}},
+		{name: "MySQL lock wait timeout", mapper: NewMySQLErrorMapper(nil), err: &mysql.MySQLError{Number: 1205}},
+		{name: "MySQL invalid connection", mapper: NewMySQLErrorMapper(nil), err: mysql.ErrInvalidConn},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mapper.Map(tt.err); !domainerror.IsRetryable(got) {
+				t.Errorf("IsRetryable(Map()) = false, want true")
+			}
+		})
+	}
+
+	if domainerror.IsRetryable(NewPostgresErrorMapper(nil).Map(&pgconn.PgError{Code: "23505"})) {
+		t.Errorf("IsRetryable(unique violation) = true, want false")
+	}
+}
+
+func TestMySQLErrorMapper_ColumnField(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *mysql.MySQLError
+		expected string
+	}{
+		{name: "Data too long", err: &mysql.MySQLError{Number: 1406, Message: "Data too long for column 'name' at row 1"}, expected: "name"},
+		{name: "Out of range", err: &mysql.MySQLError{Number: 1264, Message: "Out of range value for column 'age' at row 1"}, expected: "age"},
+		{name: "Unexpected message", err: &mysql.MySQLError{Number: 1406, Message: "Data too long"}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var derr *domainerror.DomainError
+			if !errors.As(NewMySQLErrorMapper(nil).Map(tt.err), &derr) {
+				t.Fatalf("Map() is not a DomainError")
+			}
+			if derr.Field() != tt.expected {
+				t.Errorf("Field() = %q, want %q", derr.Field(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestMySQLErrorMapper_ConnectionErrors(t *testing.T) {
+	mapper := NewMySQLErrorMapper(nil)
+
+	for _, err := range []error{mysql.ErrInvalidConn, driver.ErrBadConn, fmt.Errorf("query: %w", driver.ErrBadConn)} {
+		got := mapper.Map(err)
+
+		if !errors.Is(got, domainerror.ErrDatabaseConnection) {
+			t.Errorf("Map(%v) = %v, want %v", err, got, domainerror.ErrDatabaseConnection)
+		}
+		if !errors.Is(got, err) {
+			t.Errorf("Map(%v) lost the original error", err)
+		}
+	}
+}
+
+func TestPostgresErrorMapper_Constraints(t *testing.T) {
+	mapper := NewPostgresErrorMapperWithConstraints(NewConstraints().
+		Constraint("uk_customer_email", domainerror.ErrDuplicateEmail).
+		Constraint("fk_contract_customer", domainerror.ErrCustomerNotActive).
+		Constraint("ck_payment_balance", domainerror.ErrInsufficientBalance).
+		NotNull("contracts", "customer_id", domainerror.ErrOrphanRecord).
+		NotNull("", "email", domainerror.ErrInvalidEmail))
+
+	tests := []struct {
+		name          string
+		err           error
+		expected      *domainerror.DomainError
+		expectedField string
+	}{
+		{
+			name:     "Unique constraint",
+			err:      &pgconn.PgError{Code: "23505", ConstraintName: "uk_customer_email"},
+			expected: domainerror.ErrDuplicateEmail,
+		},
+		{
+			name:     "Foreign key constraint",
+			err:      &pgconn.PgError{Code: "23503", ConstraintName: "fk_contract_customer"},
+			expected: domainerror.ErrCustomerNotActive,
+		},
+		{
+			name:     "Unmapped foreign key",
+			err:      &pgconn.PgError{Code: "23503", ConstraintName: "fk_other"},
+			expected: domainerror.ErrInvalidRelationship,
+		},
+		{
+			name:     "Check constraint",
+			err:      &pgconn.PgError{Code: "23514", ConstraintName: "ck_payment_balance"},
+			expected: domainerror.ErrInsufficientBalance,
+		},
+		{
+			name:     "Not null by table and column",
+			err:      &pgconn.PgError{Code: "23502", TableName: "contracts", ColumnName: "customer_id"},
+			expected: domainerror.ErrOrphanRecord,
+		},
+		{
+			name:     "Not null by column in any table",
+			err:      &pgconn.PgError{Code: "23502", TableName: "leads", ColumnName: "email"},
+			expected: domainerror.ErrInvalidEmail,
+		},
+		{
+			name:          "Same column in another table",
+			err:           &pgconn.PgError{Code: "23502", TableName: "payments", ColumnName: "customer_id"},
+			expected:      domainerror.ErrRequiredField,
+			expectedField: "customer_id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapper.Map(tt.err)
+
+			if !errors.Is(got, tt.expected) {
+				t.Errorf("Map() = %v, want %v", got, tt.expected)
+			}
+
+			var derr *domainerror.DomainError
+			if errors.As(got, &derr) && derr.Field() != tt.expectedField {
+				t.Errorf("Field() = %q, want %q", derr.Field(), tt.expectedField)
+			}
+		})
+	}
+}
+
+func TestMySQLErrorMapper_Constraints(t *testing.T) {
+	mapper := NewMySQLErrorMapperWithConstraints(NewConstraints().
+		Constraint("fk_contract_customer", domainerror.ErrCustomerNotActive).
+		Constraint("fk_invoice_contract", domainerror.ErrDependencyExists).
+		Constraint("ck_payment_balance", domainerror.ErrInsufficientBalance).
+		NotNull("", "email", domainerror.ErrInvalidEmail))
+
+	tests := []struct {
+		name          string
+		err           error
+		expected      *domainerror.DomainError
+		expectedField string
+	}{
+		{
+			name: "Child row foreign key",
+			err: &mysql.MySQLError{Number: 1452, Message: "Cannot add or update a child row: a foreign key constraint fails " +
+				"(`crm`.`contracts`, CONSTRAINT `fk_contract_customer` FOREIGN KEY (`customer_id`) REFERENCES `customers` (`id`))"},
+			expected: domainerror.ErrCustomerNotActive,
+		},
+		{
+			name: "Parent row foreign key",
+			err: &mysql.MySQLError{Number: 1451, Message: "Cannot delete or update a parent row: a foreign key constraint fails " +
+				"(`crm`.`invoices`, CONSTRAINT `fk_invoice_contract` FOREIGN KEY (`contract_id`) REFERENCES `contracts` (`id`))"},
+			expected: domainerror.ErrDependencyExists,
+		},
+		{
+			name: "Unmapped foreign key",
+			err: &mysql.MySQLError{Number: 1452, Message: "Cannot add or update a child row: a foreign key constraint fails " +
+				"(`crm`.`leads`, CONSTRAINT `fk_lead_owner` FOREIGN KEY (`owner_id`) REFERENCES `users` (`id`))"},
+			expected: domainerror.ErrInvalidRelationship,
+		},
+		{
+			name:     "Check constraint",
+			err:      &mysql.MySQLError{Number: 3819, Message: "Check constraint 'ck_payment_balance' is violated."},
+			expected: domainerror.ErrInsufficientBalance,
+		},
+		{
+			name:     "Not null column",
+			err:      &mysql.MySQLError{Number: 1048, Message: "Column 'email' cannot be null"},
+			expected: domainerror.ErrInvalidEmail,
+		},
+		{
+			name:          "Unmapped not null column",
+			err:           &mysql.MySQLError{Number: 1048, Message: "Column 'name' cannot be null"},
+			expected:      domainerror.ErrRequiredField,
+			expectedField: "name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapper.Map(tt.err)
+
+			if !errors.Is(got, tt.expected) {
+				t.Errorf("Map() = %v, want %v", got, tt.expected)
+			}
+
+			var derr *domainerror.DomainError
+			if errors.As(got, &derr) && derr.Field() != tt.expectedField {
+				t.Errorf("Field() = %q, want %q", derr.Field(), tt.expectedField)
+			}
+		})
+	}
+}
+
+func TestMySQLErrorMapper_DuplicateKey(t *testing.T) {
+	mapper := NewMySQLErrorMapper(map[string]*domainerror.DomainError{
+		"uk_email":                 domainerror.ErrDuplicateEmail,
+		"uk_email_tenant":          domainerror.ErrDuplicateLead,
+		"customers.uk_document":    domainerror.ErrDuplicateCPF,
+		"uk_document":              domainerror.ErrDuplicateCNPJ,
+		"companies.PRIMARY":        domainerror.ErrDuplicateRequest,
+		"leads.uk_lead_email_hash": domainerror.ErrDuplicateLead,
+	})
+
+	tests := []struct {
+		name     string
+		message  string
+		expected *domainerror.DomainError
+	}{
+		{
+			name:     "MySQL 5.7 index",
+			message:  "Duplicate entry 'a@b.com' for key 'uk_email'",
+			expected: domainerror.ErrDuplicateEmail,
+		},
+		{
+			name:     "MySQL 5.7 overlapping index",
+			message:  "Duplicate entry 'a@b.com-7' for key 'uk_email_tenant'",
+			expected: domainerror.ErrDuplicateLead,
+		},
+		{
+			name:     "MySQL 8.0 qualified index matches bare name",
+			message:  "Duplicate entry 'a@b.com' for key 'customers.uk_email'",
+			expected: domainerror.ErrDuplicateEmail,
+		},
+		{
+			name:     "MySQL 8.0 overlapping index",
+			message:  "Duplicate entry 'a@b.com-7' for key 'leads.uk_email_tenant'",
+			expected: domainerror.ErrDuplicateLead,
+		},
+		{
+			name:     "MySQL 8.0 qualified name wins over bare name",
+			message:  "Duplicate entry '123' for key 'customers.uk_document'",
+			expected: domainerror.ErrDuplicateCPF,
+		},
+		{
+			name:     "MySQL 8.0 other table uses bare name",
+			message:  "Duplicate entry '123' for key 'companies.uk_document'",
+			expected: domainerror.ErrDuplicateCNPJ,
+		},
+		{
+			name:     "MySQL 8.0 qualified only",
+			message:  "Duplicate entry '42' for key 'companies.PRIMARY'",
+			expected: domainerror.ErrDuplicateRequest,
+		},
+		{
+			name:     "MySQL 5.7 does not match qualified only",
+			message:  "Duplicate entry 'x' for key 'uk_lead_email_hash'",
+			expected: domainerror.ErrConflict,
+		},
+		{
+			name:     "Value containing key text",
+			message:  "Duplicate entry 'x for key 'uk_email'' for key 'uk_email_tenant'",
+			expected: domainerror.ErrDuplicateLead,
+		},
+		{
+			name:     "Unknown index",
+			message:  "Duplicate entry 'x' for key 'customers.uk_other'",
+			expected: domainerror.ErrConflict,
+		},
+		{
+			name:     "Unexpected message",
+			message:  "Duplicate entry 'x' for key '",
+			expected: domainerror.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				got := mapper.Map(&mysql.MySQLError{Number: 1062, Message: tt.message})
+
+				var derr *domainerror.DomainError
+				if !errors.As(got, &derr) || derr.Code() != tt.expected.Code() {
+					t.Fatalf("Map() = %v, want %v", got, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorMapper_SentinelErrors(t *testing.T) {
+	mappers := map[string]DBErrorMapper{
+		"Postgres": NewPostgresErrorMapper(nil),
+		"MySQL":    NewMySQLErrorMapper(nil),
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected *domainerror.DomainError
+	}{
+		{name: "gorm record not found", err: gorm.ErrRecordNotFound, expected: domainerror.ErrNotFound},
+		{name: "sql no rows", err: sql.ErrNoRows, expected: domainerror.ErrNotFound},
+		{name: "Wrapped sql no rows", err: fmt.Errorf("find customer: %w", sql.ErrNoRows), expected: domainerror.ErrNotFound},
+		{name: "gorm duplicated key", err: gorm.ErrDuplicatedKey, expected: domainerror.ErrConflict},
+		{name: "gorm foreign key violated", err: gorm.ErrForeignKeyViolated, expected: domainerror.ErrInvalidRelationship},
+		{name: "gorm check constraint violated", err: gorm.ErrCheckConstraintViolated, expected: domainerror.ErrInvalidInput},
+		{name: "gorm invalid transaction", err: gorm.ErrInvalidTransaction, expected: domainerror.ErrDatabaseQuery},
+		{name: "sql tx done", err: sql.ErrTxDone, expected: domainerror.ErrDatabaseQuery},
+		{name: "sql conn done", err: sql.ErrConnDone, expected: domainerror.ErrDatabaseConnection},
+	}
+
+	for dialect, mapper := range mappers {
+		for _, tt := range tests {
+			t.Run(dialect+"/"+tt.name, func(t *testing.T) {
+				got := mapper.Map(tt.err)
+
+				var derr *domainerror.DomainError
+				if !errors.As(got, &derr) || derr.Code() != tt.expected.Code() {
+					t.Errorf("Map() = %v, want %v", got, tt.expected)
+				}
+
+				if !errors.Is(got, tt.err) {
+					t.Errorf("Map() lost the original error")
+				}
+			})
+		}
+	}
+}
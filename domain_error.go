@@ -1,156 +1,541 @@
 package domainerror
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
 
+// DomainError é imutável: os sentinels Err* são compartilhados por todo o
+// processo, então qualquer customização deve passar pelos métodos With*,
+// que devolvem uma cópia
 type DomainError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	code     string
+	message  string
+	title    string
+	template string
+	params   map[string]any
+	detail   string
+	field    string
+	details  map[string]any
+	status   int
+	category Category
+	parent   *DomainError
+
+	retryable  bool
+	transient  bool
+	retryAfter time.Duration
+
+	cause error
 }
 
 func (e *DomainError) Error() string {
-	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+	if e.cause != nil {
+		return fmt.Sprintf("%s: %s: %v", e.code, e.Message(), e.cause)
+	}
+	return fmt.Sprintf("%s: %s", e.code, e.Message())
+}
+
+// Unwrap expõe a causa original para errors.Is / errors.As
+func (e *DomainError) Unwrap() error {
+	return e.cause
+}
+
+// Is compara erros de domínio pelo Code, permitindo errors.Is(err, ErrNotFound)
+// mesmo quando err é uma cópia do sentinel com causa anexada. Também casa com
+// os ancestrais do erro: errors.Is(ErrDuplicateEmail, ErrConflict) é true
+func (e *DomainError) Is(target error) bool {
+	t, ok := target.(*DomainError)
+	if !ok || t == nil {
+		return false
+	}
+	for p := e; p != nil; p = p.parent {
+		if p.code == t.code {
+			return true
+		}
+	}
+	return false
+}
+
+// Code retorna o código estável do erro (ex: NOT_FOUND)
+func (e *DomainError) Code() string {
+	return e.code
+}
+
+// Message retorna a mensagem legível do erro. Com template, os placeholders
+// {nome} são preenchidos pelos params, pelos details ou, em {field}, pelo campo
+// do erro; se algum placeholder não tiver valor, retorna a mensagem sem template
+func (e *DomainError) Message() string {
+	if e.template == "" {
+		return e.message
+	}
+	if rendered, ok := renderTemplate(e.template, e.param); ok {
+		return rendered
+	}
+	return e.message
+}
+
+// Title retorna a mensagem genérica do código, igual em todas as ocorrências:
+// sem params nem WithMessage. É a mensagem registrada ou, em cópias
+// localizadas, a sua tradução
+func (e *DomainError) Title() string {
+	if e.title != "" {
+		return e.title
+	}
+	if registered, ok := Lookup(e.code); ok {
+		return registered.message
+	}
+	return e.message
+}
+
+// Template retorna o template da mensagem, se houver
+func (e *DomainError) Template() string {
+	return e.template
+}
+
+// Params retorna uma cópia dos valores usados no template da mensagem
+func (e *DomainError) Params() map[string]any {
+	return copyMap(e.params)
+}
+
+func (e *DomainError) param(key string) (any, bool) {
+	if value, ok := e.params[key]; ok {
+		return value, true
+	}
+	if value, ok := e.details[key]; ok {
+		return value, true
+	}
+	if key == "field" && e.field != "" {
+		return e.field, true
+	}
+	return nil, false
+}
+
+// Detail retorna o detalhe específico da ocorrência, se houver
+func (e *DomainError) Detail() string {
+	return e.detail
+}
+
+// Field retorna o campo associado ao erro, se houver
+func (e *DomainError) Field() string {
+	return e.field
+}
+
+// HTTPStatus retorna o status HTTP declarado junto à definição do erro ou,
+// sem declaração, o do ancestral mais próximo. Sem nenhum status declarado,
+// usa o status padrão da categoria; 0 quando também não há categoria
+func (e *DomainError) HTTPStatus() int {
+	for p := e; p != nil; p = p.parent {
+		if p.status != 0 {
+			return p.status
+		}
+	}
+	return e.Category().HTTPStatus()
+}
+
+// Category retorna a categoria do erro ou, sem declaração, a do ancestral
+// mais próximo
+func (e *DomainError) Category() Category {
+	for p := e; p != nil; p = p.parent {
+		if p.category != CategoryUnknown {
+			return p.category
+		}
+	}
+	return CategoryUnknown
+}
+
+// Parent retorna o erro do qual este é uma especialização, se houver
+func (e *DomainError) Parent() *DomainError {
+	return e.parent
+}
+
+// Child cria um erro mais específico que herda o status e a identidade deste:
+// errors.Is(child, e) é true. A semântica de retry é copiada e pode ser
+// sobrescrita no filho
+func (e *DomainError) Child(code, message string) *DomainError {
+	return &DomainError{
+		code:       code,
+		message:    message,
+		parent:     e,
+		retryable:  e.retryable,
+		transient:  e.transient,
+		retryAfter: e.retryAfter,
+	}
+}
+
+// Retryable indica que a mesma operação pode ser repetida com chance de sucesso
+func (e *DomainError) Retryable() bool {
+	return e.retryable
+}
+
+// Transient indica que a condição que causou o erro é temporária (ex: queda de
+// conexão), mesmo que repetir a operação não seja seguro
+func (e *DomainError) Transient() bool {
+	return e.transient || e.retryable
+}
+
+// RetryAfter retorna o tempo sugerido antes de repetir a operação; 0 quando
+// não há sugestão
+func (e *DomainError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// Details retorna uma cópia dos dados estruturados da ocorrência
+// (ex: id do recurso, limite excedido)
+func (e *DomainError) Details() map[string]any {
+	return copyMap(e.details)
+}
+
+// WithMessage retorna uma cópia do erro com outra mensagem, descartando o template
+func (e *DomainError) WithMessage(message string) *DomainError {
+	c := e.clone()
+	c.message = message
+	c.template = ""
+	return c
+}
+
+// WithTemplate retorna uma cópia do erro com o template da mensagem, ex:
+// "Arquivo muito grande: máximo {max} MB"
+func (e *DomainError) WithTemplate(template string) *DomainError {
+	c := e.clone()
+	c.template = template
+	return c
+}
+
+// WithParam retorna uma cópia do erro com um valor para o template da mensagem
+func (e *DomainError) WithParam(key string, value any) *DomainError {
+	return e.WithParams(map[string]any{key: value})
+}
+
+// WithParams retorna uma cópia do erro com os valores do template mesclados
+// aos já existentes
+func (e *DomainError) WithParams(params map[string]any) *DomainError {
+	c := e.clone()
+	c.params = e.Params()
+	if c.params == nil {
+		c.params = make(map[string]any, len(params))
+	}
+	for k, v := range params {
+		c.params[k] = v
+	}
+	return c
+}
+
+// WithDetail retorna uma cópia do erro com o detalhe da ocorrência
+func (e *DomainError) WithDetail(detail string) *DomainError {
+	c := e.clone()
+	c.detail = detail
+	return c
+}
+
+// WithField retorna uma cópia do erro associada a um campo
+func (e *DomainError) WithField(field string) *DomainError {
+	c := e.clone()
+	c.field = field
+	return c
+}
+
+// WithDetails retorna uma cópia do erro com os dados estruturados mesclados
+// aos já existentes
+func (e *DomainError) WithDetails(details map[string]any) *DomainError {
+	c := e.clone()
+	c.details = e.Details()
+	if c.details == nil {
+		c.details = make(map[string]any, len(details))
+	}
+	for k, v := range details {
+		c.details[k] = v
+	}
+	return c
+}
+
+// WithCause retorna uma cópia do erro com a causa original anexada
+func (e *DomainError) WithCause(cause error) *DomainError {
+	c := e.clone()
+	c.cause = cause
+	return c
+}
+
+// WithRetryable retorna uma cópia do erro marcada (ou não) como repetível
+func (e *DomainError) WithRetryable(retryable bool) *DomainError {
+	c := e.clone()
+	c.retryable = retryable
+	return c
+}
+
+// WithTransient retorna uma cópia do erro marcada (ou não) como temporária
+func (e *DomainError) WithTransient(transient bool) *DomainError {
+	c := e.clone()
+	c.transient = transient
+	return c
+}
+
+// WithRetryAfter retorna uma cópia do erro com o tempo sugerido antes de repetir
+func (e *DomainError) WithRetryAfter(d time.Duration) *DomainError {
+	c := e.clone()
+	c.retryAfter = d
+	return c
+}
+
+// MarshalJSON serializa apenas os campos públicos do erro; a causa nunca é exposta
+func (e *DomainError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code    string         `json:"code"`
+		Message string         `json:"message"`
+		Detail  string         `json:"detail,omitempty"`
+		Field   string         `json:"field,omitempty"`
+		Details map[string]any `json:"details,omitempty"`
+	}{
+		Code:    e.code,
+		Message: e.Message(),
+		Detail:  e.detail,
+		Field:   e.field,
+		Details: e.details,
+	})
+}
+
+func (e *DomainError) clone() *DomainError {
+	c := *e
+	return &c
+}
+
+func copyMap(m map[string]any) map[string]any {
+	if len(m) == 0 {
+		return nil
+	}
+	c := make(map[string]any, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
 }
 
 func New(code, message string) *DomainError {
 	return &DomainError{
-		Code:    code,
-		Message: message,
+		code:    code,
+		message: message,
+	}
+}
+
+// NewWithStatus cria um erro de domínio com o status HTTP declarado junto à definição
+func NewWithStatus(code, message string, status int) *DomainError {
+	return &DomainError{
+		code:    code,
+		message: message,
+		status:  status,
+	}
+}
+
+// NewWithCategory cria um erro de domínio que responde com o status padrão da
+// categoria, sem precisar declarar o status HTTP
+func NewWithCategory(code, message string, category Category) *DomainError {
+	return &DomainError{
+		code:     code,
+		message:  message,
+		category: category,
 	}
 }
 
+// Wrap cria uma cópia do sentinel com a causa original anexada
+func Wrap(sentinel *DomainError, cause error) *DomainError {
+	return sentinel.WithCause(cause)
+}
+
+// define cria e registra no registry padrão um erro deste módulo; o registry é
+// a fonte única do mapeamento código → status HTTP usado pelos mappers
+func define(code, message string, category Category, status int) *DomainError {
+	return Register(newDefinition(code, message, category, status))
+}
+
+// defineTransient registra um erro causado por condição temporária que não é
+// marcado como repetível: quem decide reenviar é o cliente (ex: REQUEST_TIMEOUT)
+func defineTransient(code, message string, category Category, status int) *DomainError {
+	err := newDefinition(code, message, category, status)
+	err.transient = true
+	return Register(err)
+}
+
+// defineRetryable registra um erro temporário que pode ser repetido
+func defineRetryable(code, message string, category Category, status int) *DomainError {
+	err := newDefinition(code, message, category, status)
+	err.retryable = true
+	err.transient = true
+	return Register(err)
+}
+
+// defineTemplate registra um erro cuja mensagem tem placeholders; message é
+// usada quando os valores não forem informados
+func defineTemplate(code, message, template string, category Category, status int) *DomainError {
+	err := newDefinition(code, message, category, status)
+	err.template = template
+	return Register(err)
+}
+
+func newDefinition(code, message string, category Category, status int) *DomainError {
+	err := NewWithStatus(code, message, status)
+	err.category = category
+	return err
+}
+
+// defineChild cria e registra no registry padrão uma especialização de parent,
+// que herda o seu status HTTP
+func defineChild(parent *DomainError, code, message string) *DomainError {
+	return Register(parent.Child(code, message))
+}
+
+// defineChildTemplate registra uma especialização de parent com template de mensagem
+func defineChildTemplate(parent *DomainError, code, message, template string) *DomainError {
+	err := parent.Child(code, message)
+	err.template = template
+	return Register(err)
+}
+
+// defineRetryableChild registra uma especialização de parent que pode ser repetida
+func defineRetryableChild(parent *DomainError, code, message string) *DomainError {
+	err := parent.Child(code, message)
+	err.retryable = true
+	err.transient = true
+	return Register(err)
+}
+
 // Erros de Validação e Input
 var (
-	ErrInvalidInput    = New("INVALID_INPUT", "Input inválido")
-	ErrInvalidEmail    = New("INVALID_EMAIL", "Email inválido")
-	ErrInvalidCPF      = New("INVALID_CPF", "CPF inválido")
-	ErrInvalidCNPJ     = New("INVALID_CNPJ", "CNPJ inválido")
-	ErrInvalidPhone    = New("INVALID_PHONE", "Telefone inválido")
-	ErrInvalidDate     = New("INVALID_DATE", "Data inválida")
-	ErrInvalidCurrency = New("INVALID_CURRENCY", "Valor monetário inválido")
-	ErrRequiredField   = New("REQUIRED_FIELD", "Campo obrigatório não informado")
+	ErrInvalidInput    = define("INVALID_INPUT", "Input inválido", CategoryValidation, http.StatusBadRequest)
+	ErrInvalidEmail    = defineChild(ErrInvalidInput, "INVALID_EMAIL", "Email inválido")
+	ErrInvalidCPF      = defineChild(ErrInvalidInput, "INVALID_CPF", "CPF inválido")
+	ErrInvalidCNPJ     = defineChild(ErrInvalidInput, "INVALID_CNPJ", "CNPJ inválido")
+	ErrInvalidPhone    = defineChild(ErrInvalidInput, "INVALID_PHONE", "Telefone inválido")
+	ErrInvalidDate     = defineChild(ErrInvalidInput, "INVALID_DATE", "Data inválida")
+	ErrInvalidCurrency = defineChild(ErrInvalidInput, "INVALID_CURRENCY", "Valor monetário inválido")
+	ErrRequiredField   = defineChildTemplate(ErrInvalidInput, "REQUIRED_FIELD", "Campo obrigatório não informado", "Campo {field} obrigatório")
 )
 
 // Erros de Registro/Recurso
 var (
-	ErrNotFound       = New("NOT_FOUND", "Registro não encontrado")
-	ErrConflict       = New("CONFLICT", "Registro já existente")
-	ErrDuplicateEmail = New("DUPLICATE_EMAIL", "Email já cadastrado")
-	ErrDuplicateCPF   = New("DUPLICATE_CPF", "CPF já cadastrado")
-	ErrDuplicateCNPJ  = New("DUPLICATE_CNPJ", "CNPJ já cadastrado")
-	ErrRecordLocked   = New("RECORD_LOCKED", "Registro bloqueado para edição")
-	ErrRecordInUse    = New("RECORD_IN_USE", "Registro em uso e não pode ser excluído")
+	ErrNotFound       = define("NOT_FOUND", "Registro não encontrado", CategoryNotFound, http.StatusNotFound)
+	ErrConflict       = define("CONFLICT", "Registro já existente", CategoryConflict, http.StatusConflict)
+	ErrDuplicateEmail = defineChild(ErrConflict, "DUPLICATE_EMAIL", "Email já cadastrado")
+	ErrDuplicateCPF   = defineChild(ErrConflict, "DUPLICATE_CPF", "CPF já cadastrado")
+	ErrDuplicateCNPJ  = defineChild(ErrConflict, "DUPLICATE_CNPJ", "CNPJ já cadastrado")
+	ErrRecordLocked   = defineTransient("RECORD_LOCKED", "Registro bloqueado para edição", CategoryConflict, http.StatusLocked)
+	ErrRecordInUse    = define("RECORD_IN_USE", "Registro em uso e não pode ser excluído", CategoryConflict, http.StatusUnprocessableEntity)
 )
 
 // Erros de Autenticação e Autorização
 var (
-	ErrUnauthorized            = New("UNAUTHORIZED", "Não autorizado")
-	ErrForbidden               = New("FORBIDDEN", "Acesso negado")
-	ErrInvalidCredentials      = New("INVALID_CREDENTIALS", "Credenciais inválidas")
-	ErrSessionExpired          = New("SESSION_EXPIRED", "Sessão expirada")
-	ErrTokenInvalid            = New("TOKEN_INVALID", "Token inválido")
-	ErrTokenExpired            = New("TOKEN_EXPIRED", "Token expirado")
-	ErrInsufficientPermissions = New("INSUFFICIENT_PERMISSIONS", "Permissões insuficientes")
+	ErrUnauthorized            = define("UNAUTHORIZED", "Não autorizado", CategoryAuthentication, http.StatusUnauthorized)
+	ErrForbidden               = define("FORBIDDEN", "Acesso negado", CategoryAuthorization, http.StatusForbidden)
+	ErrInvalidCredentials      = defineChild(ErrUnauthorized, "INVALID_CREDENTIALS", "Credenciais inválidas")
+	ErrSessionExpired          = defineChild(ErrUnauthorized, "SESSION_EXPIRED", "Sessão expirada")
+	ErrTokenInvalid            = defineChild(ErrUnauthorized, "TOKEN_INVALID", "Token inválido")
+	ErrTokenExpired            = defineChild(ErrUnauthorized, "TOKEN_EXPIRED", "Token expirado")
+	ErrInsufficientPermissions = defineChild(ErrForbidden, "INSUFFICIENT_PERMISSIONS", "Permissões insuficientes")
 )
 
 // Erros de Negócio - Financeiro
 var (
-	ErrInsufficientBalance = New("INSUFFICIENT_BALANCE", "Saldo insuficiente")
-	ErrPaymentOverdue      = New("PAYMENT_OVERDUE", "Pagamento em atraso")
-	ErrPaymentFailed       = New("PAYMENT_FAILED", "Falha no pagamento")
-	ErrInvoiceNotPaid      = New("INVOICE_NOT_PAID", "Fatura não paga")
-	ErrCreditLimitExceeded = New("CREDIT_LIMIT_EXCEEDED", "Limite de crédito excedido")
+	ErrInsufficientBalance = define("INSUFFICIENT_BALANCE", "Saldo insuficiente", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrPaymentOverdue      = define("PAYMENT_OVERDUE", "Pagamento em atraso", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrPaymentFailed       = define("PAYMENT_FAILED", "Falha no pagamento", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrInvoiceNotPaid      = define("INVOICE_NOT_PAID", "Fatura não paga", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrCreditLimitExceeded = define("CREDIT_LIMIT_EXCEEDED", "Limite de crédito excedido", CategoryBusinessRule, http.StatusUnprocessableEntity)
 )
 
 // Erros de Estado/Status
 var (
-	ErrInvalidStatus    = New("INVALID_STATUS", "Status inválido para operação")
-	ErrStatusConflict   = New("STATUS_CONFLICT", "Conflito de status")
-	ErrAccountSuspended = New("ACCOUNT_SUSPENDED", "Conta suspensa")
-	ErrAccountInactive  = New("ACCOUNT_INACTIVE", "Conta inativa")
-	ErrCompanySuspended = New("COMPANY_SUSPENDED", "Empresa suspensa por inadimplência")
+	ErrInvalidStatus    = define("INVALID_STATUS", "Status inválido para operação", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrStatusConflict   = define("STATUS_CONFLICT", "Conflito de status", CategoryConflict, http.StatusConflict)
+	ErrAccountSuspended = define("ACCOUNT_SUSPENDED", "Conta suspensa", CategoryAuthorization, http.StatusForbidden)
+	ErrAccountInactive  = define("ACCOUNT_INACTIVE", "Conta inativa", CategoryAuthorization, http.StatusForbidden)
+	ErrCompanySuspended = define("COMPANY_SUSPENDED", "Empresa suspensa por inadimplência", CategoryAuthorization, http.StatusForbidden)
 )
 
 // Erros de Idempotência e Concorrência
 var (
-	ErrDuplicateRequest       = New("DUPLICATE_REQUEST", "Requisição duplicada")
-	ErrIdempotencyKeyUsed     = New("IDEMPOTENCY_KEY_USED", "Chave de idempotência já utilizada")
-	ErrIdempotencyConflict    = New("IDEMPOTENCY_CONFLICT", "Conflito de idempotência - operação diferente com mesma chave")
-	ErrConcurrentModification = New("CONCURRENT_MODIFICATION", "Registro modificado por outro usuário")
-	ErrOptimisticLockFailed   = New("OPTIMISTIC_LOCK_FAILED", "Falha no controle de concorrência otimista")
+	ErrDuplicateRequest       = define("DUPLICATE_REQUEST", "Requisição duplicada", CategoryConflict, http.StatusConflict)
+	ErrIdempotencyKeyUsed     = define("IDEMPOTENCY_KEY_USED", "Chave de idempotência já utilizada", CategoryConflict, http.StatusConflict)
+	ErrIdempotencyConflict    = define("IDEMPOTENCY_CONFLICT", "Conflito de idempotência - operação diferente com mesma chave", CategoryConflict, http.StatusConflict)
+	ErrConcurrentModification = define("CONCURRENT_MODIFICATION", "Registro modificado por outro usuário", CategoryConflict, http.StatusConflict)
+	ErrOptimisticLockFailed   = define("OPTIMISTIC_LOCK_FAILED", "Falha no controle de concorrência otimista", CategoryConflict, http.StatusPreconditionFailed)
+	ErrSerializationFailure   = defineRetryableChild(ErrConcurrentModification, "SERIALIZATION_FAILURE", "Falha de serialização da transação")
+	ErrDeadlockDetected       = defineRetryableChild(ErrConcurrentModification, "DEADLOCK_DETECTED", "Deadlock detectado na transação")
+	ErrLockWaitTimeout        = defineRetryableChild(ErrConcurrentModification, "LOCK_WAIT_TIMEOUT", "Tempo de espera por lock excedido")
 )
 
 // Erros de Limite e Rate Limiting
 var (
-	ErrRateLimitExceeded   = New("RATE_LIMIT_EXCEEDED", "Limite de requisições excedido")
-	ErrQuotaExceeded       = New("QUOTA_EXCEEDED", "Cota excedida")
-	ErrMaxAttemptsExceeded = New("MAX_ATTEMPTS_EXCEEDED", "Número máximo de tentativas excedido")
+	ErrRateLimitExceeded   = defineRetryable("RATE_LIMIT_EXCEEDED", "Limite de requisições excedido", CategoryRateLimit, http.StatusTooManyRequests)
+	ErrQuotaExceeded       = define("QUOTA_EXCEEDED", "Cota excedida", CategoryRateLimit, http.StatusTooManyRequests)
+	ErrMaxAttemptsExceeded = defineTemplate("MAX_ATTEMPTS_EXCEEDED", "Número máximo de tentativas excedido", "Número máximo de tentativas ({max}) excedido", CategoryRateLimit, http.StatusTooManyRequests)
 )
 
 // Erros de Integração Externa
 var (
-	ErrExternalServiceUnavailable = New("EXTERNAL_SERVICE_UNAVAILABLE", "Serviço externo indisponível")
-	ErrExternalServiceTimeout     = New("EXTERNAL_SERVICE_TIMEOUT", "Timeout em serviço externo")
-	ErrThirdPartyAPIError         = New("THIRD_PARTY_API_ERROR", "Erro em API de terceiros")
+	ErrExternalServiceUnavailable = defineRetryable("EXTERNAL_SERVICE_UNAVAILABLE", "Serviço externo indisponível", CategoryExternal, http.StatusServiceUnavailable)
+	ErrExternalServiceTimeout     = defineRetryable("EXTERNAL_SERVICE_TIMEOUT", "Timeout em serviço externo", CategoryExternal, http.StatusGatewayTimeout)
+	ErrThirdPartyAPIError         = define("THIRD_PARTY_API_ERROR", "Erro em API de terceiros", CategoryExternal, http.StatusBadGateway)
 )
 
 // Erros de Relacionamento/Dependência
 var (
-	ErrOrphanRecord        = New("ORPHAN_RECORD", "Registro órfão - relacionamento obrigatório ausente")
-	ErrCircularReference   = New("CIRCULAR_REFERENCE", "Referência circular detectada")
-	ErrInvalidRelationship = New("INVALID_RELATIONSHIP", "Relacionamento inválido")
-	ErrDependencyExists    = New("DEPENDENCY_EXISTS", "Não é possível excluir - existem dependências")
+	ErrOrphanRecord        = define("ORPHAN_RECORD", "Registro órfão - relacionamento obrigatório ausente", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrCircularReference   = define("CIRCULAR_REFERENCE", "Referência circular detectada", CategoryConflict, http.StatusConflict)
+	ErrInvalidRelationship = define("INVALID_RELATIONSHIP", "Relacionamento inválido", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrDependencyExists    = define("DEPENDENCY_EXISTS", "Não é possível excluir - existem dependências", CategoryBusinessRule, http.StatusUnprocessableEntity)
 )
 
 // Erros de CRM Específicos
 var (
-	ErrLeadAlreadyConverted = New("LEAD_ALREADY_CONVERTED", "Lead já convertido em cliente")
-	ErrInvalidLeadStatus    = New("INVALID_LEAD_STATUS", "Status do lead não permite esta operação")
-	ErrDuplicateLead        = New("DUPLICATE_LEAD", "Lead duplicado")
-	ErrCustomerNotActive    = New("CUSTOMER_NOT_ACTIVE", "Cliente não está ativo")
-	ErrContractExpired      = New("CONTRACT_EXPIRED", "Contrato expirado")
-	ErrContractNotActive    = New("CONTRACT_NOT_ACTIVE", "Contrato não está ativo")
-	ErrModuleNotContracted  = New("MODULE_NOT_CONTRACTED", "Módulo não contratado pela empresa")
+	ErrLeadAlreadyConverted = define("LEAD_ALREADY_CONVERTED", "Lead já convertido em cliente", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrInvalidLeadStatus    = define("INVALID_LEAD_STATUS", "Status do lead não permite esta operação", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrDuplicateLead        = defineChild(ErrConflict, "DUPLICATE_LEAD", "Lead duplicado")
+	ErrCustomerNotActive    = define("CUSTOMER_NOT_ACTIVE", "Cliente não está ativo", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrContractExpired      = define("CONTRACT_EXPIRED", "Contrato expirado", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrContractNotActive    = define("CONTRACT_NOT_ACTIVE", "Contrato não está ativo", CategoryBusinessRule, http.StatusUnprocessableEntity)
+	ErrModuleNotContracted  = define("MODULE_NOT_CONTRACTED", "Módulo não contratado pela empresa", CategoryAuthorization, http.StatusForbidden)
 )
 
 // Erros de Arquivo/Upload
 var (
-	ErrFileTooLarge     = New("FILE_TOO_LARGE", "Arquivo muito grande")
-	ErrInvalidFileType  = New("INVALID_FILE_TYPE", "Tipo de arquivo inválido")
-	ErrFileUploadFailed = New("FILE_UPLOAD_FAILED", "Falha no upload do arquivo")
-	ErrFileNotFound     = New("FILE_NOT_FOUND", "Arquivo não encontrado")
+	ErrFileTooLarge     = defineTemplate("FILE_TOO_LARGE", "Arquivo muito grande", "Arquivo muito grande: máximo {max} MB", CategoryValidation, http.StatusRequestEntityTooLarge)
+	ErrInvalidFileType  = defineTemplate("INVALID_FILE_TYPE", "Tipo de arquivo inválido", "Tipo de arquivo inválido: {type}", CategoryValidation, http.StatusBadRequest)
+	ErrFileUploadFailed = define("FILE_UPLOAD_FAILED", "Falha no upload do arquivo", CategoryInternal, http.StatusInternalServerError)
+	ErrFileNotFound     = defineChild(ErrNotFound, "FILE_NOT_FOUND", "Arquivo não encontrado")
 )
 
 // Erros de Protocolo HTTP
 var (
-	ErrMethodNotAllowed     = New("METHOD_NOT_ALLOWED", "Método HTTP não permitido")
-	ErrNotAcceptable        = New("NOT_ACCEPTABLE", "Formato de resposta não suportado")
-	ErrRequestTimeout       = New("REQUEST_TIMEOUT", "Tempo de requisição excedido")
-	ErrUnsupportedMediaType = New("UNSUPPORTED_MEDIA_TYPE", "Tipo de mídia não suportado")
-	ErrExpectationFailed    = New("EXPECTATION_FAILED", "Expectativa não atendida")
+	ErrMethodNotAllowed     = define("METHOD_NOT_ALLOWED", "Método HTTP não permitido", CategoryValidation, http.StatusMethodNotAllowed)
+	ErrNotAcceptable        = define("NOT_ACCEPTABLE", "Formato de resposta não suportado", CategoryValidation, http.StatusNotAcceptable)
+	ErrRequestTimeout       = defineTransient("REQUEST_TIMEOUT", "Tempo de requisição excedido", CategoryInternal, http.StatusRequestTimeout)
+	ErrUnsupportedMediaType = define("UNSUPPORTED_MEDIA_TYPE", "Tipo de mídia não suportado", CategoryValidation, http.StatusUnsupportedMediaType)
+	ErrExpectationFailed    = define("EXPECTATION_FAILED", "Expectativa não atendida", CategoryValidation, http.StatusExpectationFailed)
 )
 
 // Erros de Precondição e Versionamento
 var (
-	ErrPreconditionFailed = New("PRECONDITION_FAILED", "Pré-condição falhou")
-	ErrETagMismatch       = New("ETAG_MISMATCH", "ETag não corresponde - recurso modificado")
+	ErrPreconditionFailed = define("PRECONDITION_FAILED", "Pré-condição falhou", CategoryConflict, http.StatusPreconditionFailed)
+	ErrETagMismatch       = define("ETAG_MISMATCH", "ETag não corresponde - recurso modificado", CategoryConflict, http.StatusPreconditionFailed)
 )
 
 // Erros de Remoção e Arquivamento
 var (
-	ErrResourceGone     = New("RESOURCE_GONE", "Recurso foi permanentemente removido")
-	ErrResourceArchived = New("RESOURCE_ARCHIVED", "Recurso foi arquivado")
+	ErrResourceGone     = define("RESOURCE_GONE", "Recurso foi permanentemente removido", CategoryNotFound, http.StatusGone)
+	ErrResourceArchived = define("RESOURCE_ARCHIVED", "Recurso foi arquivado", CategoryNotFound, http.StatusGone)
 )
 
 // Erros de Dependência e Compliance
 var (
-	ErrFailedDependency           = New("FAILED_DEPENDENCY", "Falha em dependência necessária")
-	ErrUnavailableForLegalReasons = New("UNAVAILABLE_FOR_LEGAL_REASONS", "Indisponível por razões legais")
+	ErrFailedDependency           = define("FAILED_DEPENDENCY", "Falha em dependência necessária", CategoryExternal, http.StatusFailedDependency)
+	ErrUnavailableForLegalReasons = define("UNAVAILABLE_FOR_LEGAL_REASONS", "Indisponível por razões legais", CategoryAuthorization, http.StatusUnavailableForLegalReasons)
 )
 
 // Erros de Sistema
 var (
-	ErrInternalServer     = New("INTERNAL_SERVER_ERROR", "Erro interno do servidor")
-	ErrDatabaseConnection = New("DATABASE_CONNECTION_ERROR", "Erro de conexão com banco de dados")
-	ErrDatabaseQuery      = New("DATABASE_QUERY_ERROR", "Erro na execução da query")
-	ErrServiceUnavailable = New("SERVICE_UNAVAILABLE", "Serviço temporariamente indisponível")
+	ErrInternalServer     = define("INTERNAL_SERVER_ERROR", "Erro interno do servidor", CategoryInternal, http.StatusInternalServerError)
+	ErrDatabaseConnection = defineRetryable("DATABASE_CONNECTION_ERROR", "Erro de conexão com banco de dados", CategoryInternal, http.StatusServiceUnavailable)
+	ErrDatabaseQuery      = define("DATABASE_QUERY_ERROR", "Erro na execução da query", CategoryInternal, http.StatusInternalServerError)
+	ErrDatabaseTimeout    = defineTransient("DATABASE_TIMEOUT", "Tempo de execução da query excedido", CategoryInternal, http.StatusGatewayTimeout)
+	ErrServiceUnavailable = defineRetryable("SERVICE_UNAVAILABLE", "Serviço temporariamente indisponível", CategoryInternal, http.StatusServiceUnavailable)
 )
@@ -1,6 +1,9 @@
 package domainerror
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 )
@@ -43,12 +46,185 @@ func TestNew(t *testing.T) {
 
 	err := New(code, message)
 
-	if err.Code != code {
-		t.Errorf("Code = %v, want %v", err.Code, code)
+	if err.Code() != code {
+		t.Errorf("Code = %v, want %v", err.Code(), code)
 	}
 
-	if err.Message != message {
-		t.Errorf("Message = %v, want %v", err.Message, message)
+	if err.Message() != message {
+		t.Errorf("Message = %v, want %v", err.Message(), message)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	cause := errors.New("connection refused")
+
+	err := Wrap(ErrNotFound, cause)
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("errors.Is(err, ErrNotFound) = false, want true")
+	}
+
+	if errors.Is(err, ErrConflict) {
+		t.Errorf("errors.Is(err, ErrConflict) = true, want false")
+	}
+
+	if !errors.Is(err, cause) {
+		t.Errorf("errors.Is(err, cause) = false, want true")
+	}
+
+	if ErrNotFound.Unwrap() != nil {
+		t.Errorf("Wrap() changed the sentinel cause to %v", ErrNotFound.Unwrap())
+	}
+
+	expected := "NOT_FOUND: Registro não encontrado: connection refused"
+	if got := err.Error(); got != expected {
+		t.Errorf("Error() = %v, want %v", got, expected)
+	}
+}
+
+func TestDomainError_IsThroughFmtWrap(t *testing.T) {
+	err := fmt.Errorf("repository: %w", Wrap(ErrDatabaseQuery, errors.New("syntax error")))
+
+	if !errors.Is(err, ErrDatabaseQuery) {
+		t.Errorf("errors.Is(err, ErrDatabaseQuery) = false, want true")
+	}
+
+	var domainErr *DomainError
+	if !errors.As(err, &domainErr) {
+		t.Fatalf("errors.As(err, *DomainError) = false, want true")
+	}
+
+	if domainErr.Code() != ErrDatabaseQuery.Code() {
+		t.Errorf("Code = %v, want %v", domainErr.Code(), ErrDatabaseQuery.Code())
+	}
+}
+
+func TestDomainError_BuildersReturnCopies(t *testing.T) {
+	original := ErrRequiredField.Message()
+
+	err := ErrRequiredField.
+		WithMessage("Nome obrigatório").
+		WithDetail("o nome do cliente deve ser informado").
+		WithField("name").
+		WithCause(errors.New("validation"))
+
+	if ErrRequiredField.Message() != original {
+		t.Errorf("sentinel Message() = %v, want %v", ErrRequiredField.Message(), original)
+	}
+
+	if ErrRequiredField.Detail() != "" || ErrRequiredField.Field() != "" || ErrRequiredField.Unwrap() != nil {
+		t.Errorf("builders changed the sentinel: %#v", ErrRequiredField)
+	}
+
+	if !errors.Is(err, ErrRequiredField) {
+		t.Errorf("errors.Is(err, ErrRequiredField) = false, want true")
+	}
+
+	if err.Message() != "Nome obrigatório" {
+		t.Errorf("Message() = %v, want %v", err.Message(), "Nome obrigatório")
+	}
+
+	if err.Detail() != "o nome do cliente deve ser informado" {
+		t.Errorf("Detail() = %v, want %v", err.Detail(), "o nome do cliente deve ser informado")
+	}
+
+	if err.Field() != "name" {
+		t.Errorf("Field() = %v, want %v", err.Field(), "name")
+	}
+}
+
+func TestDomainError_MarshalJSON(t *testing.T) {
+	err := ErrRequiredField.WithField("name").WithCause(errors.New("secret cause"))
+
+	body, marshalErr := json.Marshal(err)
+	if marshalErr != nil {
+		t.Fatalf("json.Marshal() error = %v", marshalErr)
+	}
+
+	expected := `{"code":"REQUIRED_FIELD","message":"Campo name obrigatório","field":"name"}`
+	if string(body) != expected {
+		t.Errorf("json.Marshal() = %s, want %s", body, expected)
+	}
+}
+
+func TestDomainError_WithDetails(t *testing.T) {
+	err := ErrNotFound.WithDetails(map[string]any{"resource": "customer"})
+	err2 := err.WithDetails(map[string]any{"id": "42"})
+
+	if ErrNotFound.Details() != nil {
+		t.Errorf("sentinel Details() = %v, want nil", ErrNotFound.Details())
+	}
+
+	if len(err.Details()) != 1 {
+		t.Errorf("WithDetails() changed the previous copy: %v", err.Details())
+	}
+
+	details := err2.Details()
+	if details["resource"] != "customer" || details["id"] != "42" {
+		t.Errorf("Details() = %v, want resource and id", details)
+	}
+
+	details["id"] = "changed"
+	if err2.Details()["id"] != "42" {
+		t.Errorf("Details() returned the internal map")
+	}
+
+	body, marshalErr := json.Marshal(err2)
+	if marshalErr != nil {
+		t.Fatalf("json.Marshal() error = %v", marshalErr)
+	}
+
+	expected := `{"code":"NOT_FOUND","message":"Registro não encontrado","details":{"id":"42","resource":"customer"}}`
+	if string(body) != expected {
+		t.Errorf("json.Marshal() = %s, want %s", body, expected)
+	}
+}
+
+func TestDomainError_Hierarchy(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		target   *DomainError
+		expected bool
+	}{
+		{name: "Duplicate email is a conflict", err: ErrDuplicateEmail, target: ErrConflict, expected: true},
+		{name: "Duplicate CPF is a conflict", err: ErrDuplicateCPF, target: ErrConflict, expected: true},
+		{name: "Duplicate lead is a conflict", err: ErrDuplicateLead, target: ErrConflict, expected: true},
+		{name: "Wrapped child keeps parent", err: Wrap(ErrDuplicateEmail, errors.New("23505")), target: ErrConflict, expected: true},
+		{name: "Invalid CPF is invalid input", err: ErrInvalidCPF, target: ErrInvalidInput, expected: true},
+		{name: "Token expired is unauthorized", err: ErrTokenExpired, target: ErrUnauthorized, expected: true},
+		{name: "Parent is not the child", err: ErrConflict, target: ErrDuplicateEmail, expected: false},
+		{name: "Siblings do not match", err: ErrDuplicateEmail, target: ErrDuplicateCPF, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, tt.target); got != tt.expected {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDomainError_ChildInheritsStatus(t *testing.T) {
+	mapper := NewHTTPStatusMapper()
+	duplicateContract := ErrConflict.Child("DUPLICATE_CONTRACT", "Contrato duplicado")
+
+	if duplicateContract.Parent() != ErrConflict {
+		t.Errorf("Parent() = %v, want %v", duplicateContract.Parent(), ErrConflict)
+	}
+
+	if status := mapper.GetHTTPStatus(duplicateContract); status != http.StatusConflict {
+		t.Errorf("GetHTTPStatus() = %v, want %v", status, http.StatusConflict)
+	}
+
+	if status := mapper.GetHTTPStatus(ErrFileNotFound); status != http.StatusNotFound {
+		t.Errorf("GetHTTPStatus(ErrFileNotFound) = %v, want %v", status, http.StatusNotFound)
+	}
+
+	explicit := NewWithStatus("CONTRACT_LOCKED", "Contrato bloqueado", http.StatusLocked)
+	if status := mapper.GetHTTPStatus(explicit.Child("CONTRACT_SIGNING", "Contrato em assinatura")); status != http.StatusLocked {
+		t.Errorf("GetHTTPStatus() = %v, want %v", status, http.StatusLocked)
 	}
 }
 
@@ -95,6 +271,36 @@ func TestHTTPStatusMapper_GetHTTPStatus(t *testing.T) {
 			err:            ErrInternalServer,
 			expectedStatus: http.StatusInternalServerError,
 		},
+		{
+			name:           "Record locked returns 423",
+			err:            ErrRecordLocked,
+			expectedStatus: http.StatusLocked,
+		},
+		{
+			name:           "External service unavailable returns 503",
+			err:            ErrExternalServiceUnavailable,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "Database timeout returns 504",
+			err:            ErrDatabaseTimeout,
+			expectedStatus: http.StatusGatewayTimeout,
+		},
+		{
+			name:           "Error defined outside the module uses its own status",
+			err:            NewWithStatus("DUPLICATE_CONTRACT", "Contrato duplicado", http.StatusConflict),
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Validation errors return 400",
+			err:            NewValidationErrors().AddError("/cpf", ErrInvalidCPF),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Wrapped not found returns 404",
+			err:            fmt.Errorf("repository: %w", Wrap(ErrNotFound, errors.New("no rows"))),
+			expectedStatus: http.StatusNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -107,6 +313,23 @@ func TestHTTPStatusMapper_GetHTTPStatus(t *testing.T) {
 	}
 }
 
+func TestRegistry_EveryErrorHasStatus(t *testing.T) {
+	mapper := NewHTTPStatusMapper()
+
+	if unmapped := Unmapped(); len(unmapped) != 0 {
+		t.Errorf("Unmapped() = %v, want none", unmapped)
+	}
+
+	for _, err := range All() {
+		if err.HTTPStatus() == 0 {
+			t.Errorf("%s has no HTTP status", err.Code())
+		}
+		if status := mapper.GetHTTPStatusByCode(err.Code()); status != err.HTTPStatus() {
+			t.Errorf("GetHTTPStatusByCode(%s) = %v, want %v", err.Code(), status, err.HTTPStatus())
+		}
+	}
+}
+
 func TestHTTPStatusMapper_GetHTTPStatusByCode(t *testing.T) {
 	mapper := NewHTTPStatusMapper()
 
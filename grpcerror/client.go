@@ -0,0 +1,152 @@
+package grpcerror
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	domainerror "github.com/renatofagalde/module-error"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// FromError reconstrói o erro de domínio com o Mapper padrão. Ver Mapper.FromError
+func FromError(err error) error {
+	return defaultMapper.FromError(err)
+}
+
+// UnaryClientInterceptor converte os erros de status das chamadas unary em
+// erros de domínio com o Mapper padrão
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return defaultMapper.UnaryClientInterceptor()
+}
+
+// StreamClientInterceptor converte os erros de status das chamadas stream em
+// erros de domínio com o Mapper padrão
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return defaultMapper.StreamClientInterceptor()
+}
+
+// FromError reconstrói o erro de domínio a partir de um erro de status gRPC,
+// usando ErrorInfo.Reason para encontrar o erro registrado. Apenas ErrorInfo
+// com o domínio deste Mapper é considerado: erros de outras APIs, sem ErrorInfo
+// ou de outro domínio, são retornados sem alteração. Códigos registrados mantêm
+// a mensagem da definição, com os params do template e o RetryDelay de
+// RetryInfo, para que templates e traduções continuem valendo; o status
+// original, com a mensagem do servidor, continua acessível como causa
+func (m *Mapper) FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	var (
+		info       *errdetails.ErrorInfo
+		badRequest *errdetails.BadRequest
+		retryInfo  *errdetails.RetryInfo
+	)
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		case *errdetails.RetryInfo:
+			retryInfo = d
+		}
+	}
+
+	if info == nil || info.Reason == "" || info.Domain != m.domain {
+		return err
+	}
+
+	if info.Reason == domainerror.ErrInvalidInput.Code() && badRequest != nil && len(badRequest.FieldViolations) > 0 {
+		verrs := domainerror.NewValidationErrors()
+		for _, v := range badRequest.FieldViolations {
+			verrs.Add(v.Field, v.Reason, v.Description, nil)
+		}
+		return verrs.WithCause(err)
+	}
+
+	derr, registered := domainerror.Lookup(info.Reason)
+	if !registered {
+		derr = domainerror.New(info.Reason, st.Message())
+	}
+
+	details := make(map[string]any)
+	params := make(map[string]any)
+	for k, v := range info.Metadata {
+		if param, ok := strings.CutPrefix(k, paramMetadataPrefix); ok {
+			params[param] = v
+			continue
+		}
+		switch k {
+		case "detail":
+			derr = derr.WithDetail(v)
+		case "field":
+			derr = derr.WithField(v)
+		default:
+			details[k] = v
+		}
+	}
+	if len(details) > 0 {
+		derr = derr.WithDetails(details)
+	}
+	if len(params) > 0 {
+		derr = derr.WithParams(params)
+	}
+
+	if delay := retryInfo.GetRetryDelay().AsDuration(); delay > 0 {
+		derr = derr.WithRetryAfter(delay)
+	}
+
+	return derr.WithCause(err)
+}
+
+// UnaryClientInterceptor converte os erros de status das chamadas unary em
+// erros de domínio, para que errors.Is(err, domainerror.ErrNotFound) funcione
+// entre serviços
+func (m *Mapper) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return m.FromError(invoker(ctx, method, req, reply, cc, opts...))
+	}
+}
+
+// StreamClientInterceptor converte os erros de status das chamadas stream em
+// erros de domínio
+func (m *Mapper) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, m.FromError(err)
+		}
+		return &clientStream{ClientStream: stream, mapper: m}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	mapper *Mapper
+}
+
+func (s *clientStream) SendMsg(m any) error {
+	return s.fromStreamError(s.ClientStream.SendMsg(m))
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	return s.fromStreamError(s.ClientStream.RecvMsg(m))
+}
+
+// fromStreamError preserva io.EOF, que sinaliza o fim normal do stream
+func (s *clientStream) fromStreamError(err error) error {
+	if errors.Is(err, io.EOF) {
+		return err
+	}
+	return s.mapper.FromError(err)
+}
@@ -0,0 +1,216 @@
+package grpcerror
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	domainerror "github.com/renatofagalde/module-error"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFromError_RoundTrip(t *testing.T) {
+	original := domainerror.ErrNotFound.
+		WithDetail("cliente 42").
+		WithDetails(map[string]any{"id": "42"})
+
+	err := FromError(Err(original))
+
+	if !errors.Is(err, domainerror.ErrNotFound) {
+		t.Fatalf("errors.Is(err, ErrNotFound) = false, want true: %v", err)
+	}
+
+	var derr *domainerror.DomainError
+	if !errors.As(err, &derr) {
+		t.Fatalf("errors.As(err, *DomainError) = false, want true")
+	}
+
+	if derr.Detail() != "cliente 42" || derr.Details()["id"] != "42" {
+		t.Errorf("Detail() = %v, Details() = %v, want cliente 42 and id 42", derr.Detail(), derr.Details())
+	}
+
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("status.Code() = %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestFromError_ValidationErrors(t *testing.T) {
+	original := domainerror.NewValidationErrors().
+		AddError("/email", domainerror.ErrInvalidEmail)
+
+	err := FromError(Err(original))
+
+	var verrs *domainerror.ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("errors.As(err, *ValidationErrors) = false, want true: %v", err)
+	}
+
+	violations := verrs.Violations()
+	if len(violations) != 1 || violations[0].Path != "/email" || violations[0].Code != "INVALID_EMAIL" {
+		t.Errorf("Violations() = %v, want /email INVALID_EMAIL", violations)
+	}
+
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("status.Code() = %v, want %v: original status is not reachable", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestFromError_RegisteredCodeKeepsDefinition(t *testing.T) {
+	sent := domainerror.LocalizeError(domainerror.ErrRequiredField.WithField("email"), domainerror.LocaleEN)
+
+	err := FromError(Err(sent))
+
+	var derr *domainerror.DomainError
+	if !errors.As(err, &derr) {
+		t.Fatalf("errors.As(err, *DomainError) = false, want true")
+	}
+
+	if derr.Message() != "Campo email obrigatório" {
+		t.Errorf("Message() = %v, want Campo email obrigatório", derr.Message())
+	}
+
+	if got := domainerror.Localize(err, domainerror.LocaleES); got != "El campo email es obligatorio" {
+		t.Errorf("Localize() = %v, want El campo email es obligatorio", got)
+	}
+
+	if st, _ := status.FromError(derr.Unwrap()); st.Message() != "Field email is required" {
+		t.Errorf("cause message = %v, want Field email is required", st.Message())
+	}
+}
+
+func TestFromError_OccurrenceData(t *testing.T) {
+	tests := []struct {
+		name               string
+		sent               *domainerror.DomainError
+		expectedMessage    string
+		expectedRetryAfter time.Duration
+	}{
+		{
+			name:            "Template params",
+			sent:            domainerror.ErrMaxAttemptsExceeded.WithParam("max", 3),
+			expectedMessage: "Número máximo de tentativas (3) excedido",
+		},
+		{
+			name:               "Retry delay",
+			sent:               domainerror.ErrRateLimitExceeded.WithRetryAfter(30 * time.Second),
+			expectedMessage:    "Limite de requisições excedido",
+			expectedRetryAfter: 30 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := FromError(Err(tt.sent))
+
+			if got := domainerror.Localize(err, domainerror.LocalePtBR); got != tt.expectedMessage {
+				t.Errorf("Message = %v, want %v", got, tt.expectedMessage)
+			}
+			if got := domainerror.RetryAfter(err); got != tt.expectedRetryAfter {
+				t.Errorf("RetryAfter() = %v, want %v", got, tt.expectedRetryAfter)
+			}
+		})
+	}
+
+	if got := domainerror.Localize(FromError(Err(domainerror.ErrMaxAttemptsExceeded.WithParam("max", 3))), domainerror.LocaleEN); got != "Maximum number of attempts (3) exceeded" {
+		t.Errorf("Localize() = %v, want Maximum number of attempts (3) exceeded", got)
+	}
+}
+
+func TestFromError_UnregisteredReason(t *testing.T) {
+	mapper := NewMapper("billing")
+	err := mapper.FromError(mapper.Err(domainerror.New("INVOICE_DISPUTED", "Fatura contestada")))
+
+	var derr *domainerror.DomainError
+	if !errors.As(err, &derr) || derr.Code() != "INVOICE_DISPUTED" || derr.Message() != "Fatura contestada" {
+		t.Errorf("FromError() = %v, want INVOICE_DISPUTED: Fatura contestada", err)
+	}
+}
+
+func TestFromError_OtherDomain(t *testing.T) {
+	st, detailsErr := status.New(codes.ResourceExhausted, "Quota exceeded for quota metric").
+		WithDetails(&errdetails.ErrorInfo{Reason: "RATE_LIMIT_EXCEEDED", Domain: "googleapis.com"})
+	if detailsErr != nil {
+		t.Fatalf("WithDetails() error = %v", detailsErr)
+	}
+	original := st.Err()
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "Third-party API", err: original},
+		{name: "Another service domain", err: NewMapper("billing").Err(domainerror.ErrNotFound)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := FromError(tt.err); err != tt.err {
+				t.Errorf("FromError() = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestFromError_WithoutErrorInfo(t *testing.T) {
+	original := status.Error(codes.Unavailable, "connection refused")
+
+	if err := FromError(original); err != original {
+		t.Errorf("FromError() = %v, want %v", err, original)
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := UnaryClientInterceptor()
+
+	err := interceptor(context.Background(), "/crm.Customers/Get", nil, nil, nil,
+		func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return Err(domainerror.ErrCustomerNotActive)
+		})
+
+	if !errors.Is(err, domainerror.ErrCustomerNotActive) {
+		t.Errorf("errors.Is(err, ErrCustomerNotActive) = false, want true: %v", err)
+	}
+}
+
+type fakeClientStream struct {
+	grpc.ClientStream
+	err error
+}
+
+func (s *fakeClientStream) RecvMsg(m any) error {
+	return s.err
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	interceptor := StreamClientInterceptor()
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "Status error", err: Err(domainerror.ErrForbidden), want: domainerror.ErrForbidden},
+		{name: "End of stream", err: io.EOF, want: io.EOF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := interceptor(context.Background(), &grpc.StreamDesc{}, nil, "/crm.Customers/List",
+				func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+					return &fakeClientStream{err: tt.err}, nil
+				})
+			if err != nil {
+				t.Fatalf("interceptor() error = %v", err)
+			}
+
+			if err := stream.RecvMsg(nil); !errors.Is(err, tt.want) {
+				t.Errorf("RecvMsg() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package grpcerror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	domainerror "github.com/renatofagalde/module-error"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// DefaultDomain é o domínio usado em errdetails.ErrorInfo pelo Mapper padrão
+const DefaultDomain = "module-error"
+
+// paramMetadataPrefix identifica, em ErrorInfo.Metadata, os params do template
+// da mensagem (ex: param.max)
+const paramMetadataPrefix = "param."
+
+// Mapper converte erros de domínio em status gRPC
+type Mapper struct {
+	domain       string
+	codeByError  map[string]codes.Code
+	statusMapper *domainerror.HTTPStatusMapper
+}
+
+var defaultMapper = NewMapper(DefaultDomain)
+
+// NewMapper cria um mapper que preenche ErrorInfo.Domain com domain
+func NewMapper(domain string) *Mapper {
+	mapper := &Mapper{
+		domain:       domain,
+		codeByError:  make(map[string]codes.Code),
+		statusMapper: domainerror.NewHTTPStatusMapper(),
+	}
+	mapper.initialize()
+	return mapper
+}
+
+// initialize configura os códigos em que o status HTTP não basta para escolher
+// o code gRPC; os demais são derivados do status HTTP (ver codeForHTTPStatus)
+func (m *Mapper) initialize() {
+	// Aborted: conflitos de concorrência, o cliente pode repetir a transação
+	m.codeByError[domainerror.ErrConcurrentModification.Code()] = codes.Aborted
+	m.codeByError[domainerror.ErrOptimisticLockFailed.Code()] = codes.Aborted
+	m.codeByError[domainerror.ErrRecordLocked.Code()] = codes.Aborted
+
+	// FailedPrecondition: estado do recurso não permite a operação
+	m.codeByError[domainerror.ErrStatusConflict.Code()] = codes.FailedPrecondition
+	m.codeByError[domainerror.ErrCircularReference.Code()] = codes.FailedPrecondition
+}
+
+// Code retorna o code gRPC correspondente ao erro
+func (m *Mapper) Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+
+	var derr *domainerror.DomainError
+	if !errors.As(err, &derr) {
+		if st, ok := status.FromError(err); ok {
+			return st.Code()
+		}
+		switch {
+		case errors.Is(err, context.Canceled):
+			return codes.Canceled
+		case errors.Is(err, context.DeadlineExceeded):
+			return codes.DeadlineExceeded
+		}
+		return codes.Internal
+	}
+
+	for p := derr; p != nil; p = p.Parent() {
+		if code, ok := m.codeByError[p.Code()]; ok {
+			return code
+		}
+	}
+	return codeForHTTPStatus(m.statusMapper.GetHTTPStatus(derr))
+}
+
+// Status converte o erro em status gRPC com ErrorInfo (Reason = código do erro),
+// BadRequest para violações de campo e RetryInfo para erros que podem ser
+// repetidos (domainerror.IsRetryable). Erros que já são status gRPC são retornados sem alteração
+func (m *Mapper) Status(err error) *status.Status {
+	if err == nil {
+		return nil
+	}
+
+	code := m.Code(err)
+
+	var derr *domainerror.DomainError
+	if !errors.As(err, &derr) {
+		if st, ok := status.FromError(err); ok {
+			return st
+		}
+		// Erros desconhecidos não expõem a mensagem original: cancelamento e
+		// deadline usam apenas o texto do erro do context
+		switch code {
+		case codes.Canceled:
+			return status.New(code, context.Canceled.Error())
+		case codes.DeadlineExceeded:
+			return status.New(code, context.DeadlineExceeded.Error())
+		}
+		derr = domainerror.ErrInternalServer
+	}
+
+	st := status.New(code, derr.Message())
+	details := []protoadapt.MessageV1{m.errorInfo(derr)}
+
+	if badRequest := badRequestOf(err, derr); badRequest != nil {
+		details = append(details, badRequest)
+	}
+
+	if derr.Retryable() {
+		details = append(details, retryInfo(derr))
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st
+	}
+	return withDetails
+}
+
+// Err é um atalho para Status(err).Err()
+func (m *Mapper) Err(err error) error {
+	if err == nil {
+		return nil
+	}
+	return m.Status(err).Err()
+}
+
+func (m *Mapper) errorInfo(derr *domainerror.DomainError) *errdetails.ErrorInfo {
+	metadata := make(map[string]string)
+	for k, v := range derr.Details() {
+		metadata[k] = fmt.Sprint(v)
+	}
+	for k, v := range derr.Params() {
+		metadata[paramMetadataPrefix+k] = fmt.Sprint(v)
+	}
+	if derr.Detail() != "" {
+		metadata["detail"] = derr.Detail()
+	}
+	if derr.Field() != "" {
+		metadata["field"] = derr.Field()
+	}
+
+	return &errdetails.ErrorInfo{
+		Reason:   derr.Code(),
+		Domain:   m.domain,
+		Metadata: metadata,
+	}
+}
+
+// badRequestOf monta BadRequest a partir de ValidationErrors ou do campo do erro
+func badRequestOf(err error, derr *domainerror.DomainError) *errdetails.BadRequest {
+	var verrs *domainerror.ValidationErrors
+	if errors.As(err, &verrs) {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range verrs.Violations() {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Path,
+				Reason:      v.Code,
+				Description: v.Message,
+			})
+		}
+		return badRequest
+	}
+
+	if derr.Field() != "" {
+		return &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       derr.Field(),
+				Reason:      derr.Code(),
+				Description: derr.Message(),
+			}},
+		}
+	}
+	return nil
+}
+
+// retryInfo sugere o RetryAfter do erro como RetryDelay, quando informado
+func retryInfo(derr *domainerror.DomainError) *errdetails.RetryInfo {
+	info := &errdetails.RetryInfo{}
+	if d := derr.RetryAfter(); d > 0 {
+		info.RetryDelay = durationpb.New(d)
+	}
+	return info
+}
+
+// codeForHTTPStatus deriva o code gRPC do status HTTP do erro
+func codeForHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest,
+		http.StatusNotAcceptable,
+		http.StatusRequestEntityTooLarge,
+		http.StatusUnsupportedMediaType:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden, http.StatusUnavailableForLegalReasons:
+		return codes.PermissionDenied
+	case http.StatusNotFound, http.StatusGone:
+		return codes.NotFound
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusLocked:
+		return codes.Aborted
+	case http.StatusPreconditionFailed,
+		http.StatusExpectationFailed,
+		http.StatusUnprocessableEntity,
+		http.StatusFailedDependency:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return codes.Unavailable
+	}
+	return codes.Internal
+}
+
+// Code retorna o code gRPC do erro com o Mapper padrão
+func Code(err error) codes.Code {
+	return defaultMapper.Code(err)
+}
+
+// Status converte o erro em status gRPC com o Mapper padrão
+func Status(err error) *status.Status {
+	return defaultMapper.Status(err)
+}
+
+// Err converte o erro em erro de status gRPC com o Mapper padrão
+func Err(err error) error {
+	return defaultMapper.Err(err)
+}
@@ -0,0 +1,188 @@
+package grpcerror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	domainerror "github.com/renatofagalde/module-error"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMapper_Code(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{name: "Nil", err: nil, expected: codes.OK},
+		{name: "Invalid input", err: domainerror.ErrInvalidInput, expected: codes.InvalidArgument},
+		{name: "Unauthorized", err: domainerror.ErrUnauthorized, expected: codes.Unauthenticated},
+		{name: "Forbidden", err: domainerror.ErrForbidden, expected: codes.PermissionDenied},
+		{name: "Not found", err: domainerror.ErrNotFound, expected: codes.NotFound},
+		{name: "Duplicate email", err: domainerror.ErrDuplicateEmail, expected: codes.AlreadyExists},
+		{name: "Concurrent modification", err: domainerror.ErrConcurrentModification, expected: codes.Aborted},
+		{name: "Precondition failed", err: domainerror.ErrPreconditionFailed, expected: codes.FailedPrecondition},
+		{name: "Insufficient balance", err: domainerror.ErrInsufficientBalance, expected: codes.FailedPrecondition},
+		{name: "Rate limit", err: domainerror.ErrRateLimitExceeded, expected: codes.ResourceExhausted},
+		{name: "External timeout", err: domainerror.ErrExternalServiceTimeout, expected: codes.DeadlineExceeded},
+		{name: "Service unavailable", err: domainerror.ErrServiceUnavailable, expected: codes.Unavailable},
+		{name: "Database query", err: domainerror.ErrDatabaseQuery, expected: codes.Internal},
+		{name: "Wrapped domain error", err: fmt.Errorf("repo: %w", domainerror.ErrNotFound), expected: codes.NotFound},
+		{name: "Context canceled", err: context.Canceled, expected: codes.Canceled},
+		{name: "Existing status", err: status.Error(codes.Unimplemented, "x"), expected: codes.Unimplemented},
+		{name: "Unknown error", err: errors.New("boom"), expected: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Code(tt.err); got != tt.expected {
+				t.Errorf("Code() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMapper_CodeCoversRegistry(t *testing.T) {
+	for _, err := range domainerror.All() {
+		if code := Code(err); code == codes.OK || code == codes.Unknown {
+			t.Errorf("Code(%s) = %v", err.Code(), code)
+		}
+	}
+}
+
+func TestMapper_StatusDetails(t *testing.T) {
+	mapper := NewMapper("crm.example.com")
+
+	err := domainerror.NewValidationErrors().
+		AddError("/document/cpf", domainerror.ErrInvalidCPF)
+
+	st := mapper.Status(err)
+
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("Code() = %v, want %v", st.Code(), codes.InvalidArgument)
+	}
+
+	var (
+		info       *errdetails.ErrorInfo
+		badRequest *errdetails.BadRequest
+	)
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+
+	if info == nil || info.Reason != "INVALID_INPUT" || info.Domain != "crm.example.com" {
+		t.Errorf("ErrorInfo = %v, want reason INVALID_INPUT and domain crm.example.com", info)
+	}
+
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 ||
+		badRequest.FieldViolations[0].Field != "/document/cpf" ||
+		badRequest.FieldViolations[0].Reason != "INVALID_CPF" {
+		t.Errorf("BadRequest = %v, want /document/cpf INVALID_CPF", badRequest)
+	}
+}
+
+func TestMapper_StatusRetryInfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		expected      bool
+		expectedDelay time.Duration
+	}{
+		{name: "Rate limit", err: domainerror.ErrRateLimitExceeded, expected: true},
+		{name: "Rate limit with hint", err: domainerror.ErrRateLimitExceeded.WithRetryAfter(30 * time.Second), expected: true, expectedDelay: 30 * time.Second},
+		{name: "Deadlock", err: domainerror.ErrDeadlockDetected, expected: true},
+		{name: "Concurrent modification", err: domainerror.ErrConcurrentModification, expected: false},
+		{name: "Validation", err: domainerror.ErrInvalidCPF, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info *errdetails.RetryInfo
+			for _, detail := range Status(tt.err).Details() {
+				if d, ok := detail.(*errdetails.RetryInfo); ok {
+					info = d
+				}
+			}
+
+			if (info != nil) != tt.expected {
+				t.Fatalf("RetryInfo = %v, want present = %v", info, tt.expected)
+			}
+			if info != nil && info.GetRetryDelay().AsDuration() != tt.expectedDelay {
+				t.Errorf("RetryDelay = %v, want %v", info.GetRetryDelay().AsDuration(), tt.expectedDelay)
+			}
+		})
+	}
+}
+
+func TestMapper_StatusHidesUnknownErrors(t *testing.T) {
+	st := Status(errors.New("pq: password authentication failed"))
+
+	if st.Message() != domainerror.ErrInternalServer.Message() {
+		t.Errorf("Message() = %v, want %v", st.Message(), domainerror.ErrInternalServer.Message())
+	}
+}
+
+func TestMapper_StatusHidesContextErrorChain(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{name: "Deadline", err: fmt.Errorf("query customers: %w", context.DeadlineExceeded), expectedCode: codes.DeadlineExceeded, expectedMessage: "context deadline exceeded"},
+		{name: "Canceled", err: fmt.Errorf("query customers: %w", context.Canceled), expectedCode: codes.Canceled, expectedMessage: "context canceled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := Status(tt.err)
+
+			if st.Code() != tt.expectedCode || st.Message() != tt.expectedMessage {
+				t.Errorf("Status() = %v %q, want %v %q", st.Code(), st.Message(), tt.expectedCode, tt.expectedMessage)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return nil, domainerror.ErrNotFound
+	})
+
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("status.Code() = %v, want %v", status.Code(err), codes.NotFound)
+	}
+
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+
+	if err != nil || resp != "ok" {
+		t.Errorf("interceptor() = %v, %v, want ok, nil", resp, err)
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := StreamServerInterceptor()
+
+	err := interceptor(nil, nil, &grpc.StreamServerInfo{}, func(srv any, stream grpc.ServerStream) error {
+		return domainerror.ErrForbidden
+	})
+
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("status.Code() = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+}
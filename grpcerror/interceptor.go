@@ -0,0 +1,39 @@
+package grpcerror
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor converte os erros retornados pelos handlers unary em
+// status gRPC com o Mapper padrão
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return defaultMapper.UnaryServerInterceptor()
+}
+
+// StreamServerInterceptor converte os erros retornados pelos handlers stream em
+// status gRPC com o Mapper padrão
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return defaultMapper.StreamServerInterceptor()
+}
+
+// UnaryServerInterceptor converte os erros retornados pelos handlers unary em
+// status gRPC
+func (m *Mapper) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, m.Err(err)
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor converte os erros retornados pelos handlers stream em
+// status gRPC
+func (m *Mapper) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return m.Err(handler(srv, ss))
+	}
+}
@@ -0,0 +1,180 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+// maxErrorBodySize limita a leitura do corpo de respostas de erro de outros serviços
+const maxErrorBodySize = 64 << 10
+
+// UpstreamError é a causa anexada aos erros decodificados por DecodeResponse,
+// com o status HTTP e os textos devolvidos pelo serviço chamado. Message é a
+// mensagem (ou o title do problem+json) como o serviço a renderizou
+type UpstreamError struct {
+	StatusCode int
+	Status     string
+	Message    string
+	Detail     string
+	Details    map[string]any
+	Body       []byte
+}
+
+func (e *UpstreamError) Error() string {
+	return fmt.Sprintf("upstream respondeu %s", e.Status)
+}
+
+// responseBody cobre tanto o corpo {code, message} quanto o problem+json
+type responseBody struct {
+	Code       string                  `json:"code"`
+	Message    string                  `json:"message"`
+	Title      string                  `json:"title"`
+	Detail     string                  `json:"detail"`
+	Field      string                  `json:"field"`
+	Details    map[string]any          `json:"details"`
+	Violations []domainerror.Violation `json:"violations"`
+}
+
+// DecodeResponse converte uma resposta de erro (status >= 400) de outro serviço
+// em erro de domínio. Corpos {code, message} e problem+json viram o erro
+// registrado com o mesmo código, que mantém a mensagem da definição para que
+// templates e traduções continuem valendo; se a mensagem recebida for própria
+// da ocorrência (ex: template preenchido), ela vai em Detail quando o corpo não
+// traz detail. Códigos não registrados usam a mensagem recebida. Corpos não
+// reconhecidos são classificados pelo status. O header Retry-After vira
+// RetryAfter. A resposta original fica acessível via errors.As(err,
+// *UpstreamError), inclusive em ValidationErrors. O corpo é consumido, mas
+// continua sob responsabilidade de quem chamou fechá-lo
+func DecodeResponse(resp *http.Response) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	upstream := &UpstreamError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       body,
+	}
+
+	retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+
+	var decoded responseBody
+	if err := json.Unmarshal(body, &decoded); err != nil || decoded.Code == "" {
+		return withRetryAfter(errorForStatus(resp.StatusCode), retryAfter).WithCause(upstream)
+	}
+
+	upstream.Message = decoded.Message
+	if upstream.Message == "" {
+		upstream.Message = decoded.Title
+	}
+	upstream.Detail = decoded.Detail
+	upstream.Details = decoded.Details
+
+	if decoded.Code == domainerror.ErrInvalidInput.Code() && len(decoded.Violations) > 0 {
+		verrs := domainerror.NewValidationErrors()
+		for _, v := range decoded.Violations {
+			verrs.Add(v.Path, v.Code, v.Message, v.Params)
+		}
+		return verrs.WithCause(upstream)
+	}
+
+	derr, registered := domainerror.Lookup(decoded.Code)
+	if !registered {
+		derr = domainerror.NewWithStatus(decoded.Code, upstream.Message, resp.StatusCode)
+	}
+	if decoded.Field != "" {
+		derr = derr.WithField(decoded.Field)
+	}
+	if len(decoded.Details) > 0 {
+		derr = derr.WithDetails(decoded.Details)
+	}
+	switch {
+	case decoded.Detail != "":
+		derr = derr.WithDetail(decoded.Detail)
+	case registered && upstream.Message != "" && !isDefinitionMessage(derr, upstream.Message):
+		derr = derr.WithDetail(upstream.Message)
+	}
+
+	return withRetryAfter(derr, retryAfter).WithCause(upstream)
+}
+
+// isDefinitionMessage indica se message é a mensagem do erro em algum dos
+// locales do catálogo padrão, ou seja, não traz dados da ocorrência
+func isDefinitionMessage(derr *domainerror.DomainError, message string) bool {
+	for _, locale := range domainerror.DefaultCatalog().Locales() {
+		if domainerror.Localize(derr, locale) == message {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRetryAfter lê o header Retry-After em segundos ou como data HTTP; 0
+// quando ausente ou inválido
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+func withRetryAfter(derr *domainerror.DomainError, d time.Duration) *domainerror.DomainError {
+	if d <= 0 {
+		return derr
+	}
+	return derr.WithRetryAfter(d)
+}
+
+// errorForStatus classifica respostas sem corpo reconhecível
+func errorForStatus(statusCode int) *domainerror.DomainError {
+	switch statusCode {
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return domainerror.ErrExternalServiceTimeout
+	case http.StatusServiceUnavailable:
+		return domainerror.ErrExternalServiceUnavailable
+	}
+	return domainerror.ErrThirdPartyAPIError
+}
+
+// RoundTripper converte respostas de erro em erros de domínio via DecodeResponse.
+// Com http.Client, o erro chega embrulhado em *url.Error e continua compatível
+// com errors.Is / errors.As
+type RoundTripper struct {
+	next http.RoundTripper
+}
+
+// NewRoundTripper embrulha next; se next for nil, usa http.DefaultTransport
+func NewRoundTripper(next http.RoundTripper) *RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &RoundTripper{next: next}
+}
+
+func (t *RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if decodeErr := DecodeResponse(resp); decodeErr != nil {
+		resp.Body.Close()
+		return nil, decodeErr
+	}
+	return resp, nil
+}
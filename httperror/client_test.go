@@ -0,0 +1,246 @@
+package httperror
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestDecodeResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		expected   *domainerror.DomainError
+	}{
+		{
+			name:       "Success",
+			statusCode: http.StatusOK,
+			body:       `{"id":"42"}`,
+			expected:   nil,
+		},
+		{
+			name:       "Code and message body",
+			statusCode: http.StatusNotFound,
+			body:       `{"code":"NOT_FOUND","message":"Registro não encontrado"}`,
+			expected:   domainerror.ErrNotFound,
+		},
+		{
+			name:       "Problem JSON body",
+			statusCode: http.StatusConflict,
+			body:       `{"type":"about:blank","title":"Email já cadastrado","status":409,"code":"DUPLICATE_EMAIL"}`,
+			expected:   domainerror.ErrDuplicateEmail,
+		},
+		{
+			name:       "HTML body with 503",
+			statusCode: http.StatusServiceUnavailable,
+			body:       `<html>maintenance</html>`,
+			expected:   domainerror.ErrExternalServiceUnavailable,
+		},
+		{
+			name:       "Empty body with 504",
+			statusCode: http.StatusGatewayTimeout,
+			body:       ``,
+			expected:   domainerror.ErrExternalServiceTimeout,
+		},
+		{
+			name:       "JSON without code",
+			statusCode: http.StatusBadRequest,
+			body:       `{"error":"bad"}`,
+			expected:   domainerror.ErrThirdPartyAPIError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DecodeResponse(newResponse(tt.statusCode, tt.body))
+
+			if tt.expected == nil {
+				if err != nil {
+					t.Errorf("DecodeResponse() = %v, want nil", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("DecodeResponse() = %v, want %v", err, tt.expected)
+			}
+
+			var upstream *UpstreamError
+			if !errors.As(err, &upstream) || upstream.StatusCode != tt.statusCode {
+				t.Errorf("UpstreamError = %v, want status %v", upstream, tt.statusCode)
+			}
+		})
+	}
+}
+
+func TestDecodeResponse_UnregisteredCode(t *testing.T) {
+	err := DecodeResponse(newResponse(http.StatusConflict, `{"code":"INVOICE_DISPUTED","message":"Fatura contestada","details":{"invoice":"7"}}`))
+
+	var derr *domainerror.DomainError
+	if !errors.As(err, &derr) {
+		t.Fatalf("errors.As(err, *DomainError) = false, want true")
+	}
+
+	if derr.Code() != "INVOICE_DISPUTED" || derr.Message() != "Fatura contestada" || derr.Details()["invoice"] != "7" {
+		t.Errorf("DecodeResponse() = %v, details %v", derr, derr.Details())
+	}
+
+	if status := NewDefaultHTTPStatusMapper().Status(err); status != http.StatusConflict {
+		t.Errorf("Status() = %v, want %v", status, http.StatusConflict)
+	}
+}
+
+func TestDecodeResponse_Violations(t *testing.T) {
+	err := DecodeResponse(newResponse(http.StatusBadRequest,
+		`{"code":"INVALID_INPUT","message":"Invalid input","detail":"2 campos inválidos","details":{"form":"signup"},`+
+			`"violations":[{"path":"/cpf","code":"INVALID_CPF","message":"CPF inválido"}]}`))
+
+	var verrs *domainerror.ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs.Violations()) != 1 {
+		t.Errorf("DecodeResponse() = %v, want ValidationErrors with 1 violation", err)
+	}
+
+	var upstream *UpstreamError
+	if !errors.As(err, &upstream) {
+		t.Fatalf("errors.As(err, *UpstreamError) = false, want true")
+	}
+
+	if upstream.StatusCode != http.StatusBadRequest || upstream.Message != "Invalid input" ||
+		upstream.Detail != "2 campos inválidos" || upstream.Details["form"] != "signup" {
+		t.Errorf("UpstreamError = %+v, want status 400 with message, detail and details", upstream)
+	}
+}
+
+func TestDecodeResponse_RegisteredCodeKeepsDefinition(t *testing.T) {
+	err := DecodeResponse(newResponse(http.StatusBadRequest,
+		`{"code":"REQUIRED_FIELD","message":"Field email is required","field":"email"}`))
+
+	var derr *domainerror.DomainError
+	if !errors.As(err, &derr) {
+		t.Fatalf("errors.As(err, *DomainError) = false, want true")
+	}
+
+	if derr.Message() != "Campo email obrigatório" {
+		t.Errorf("Message() = %v, want Campo email obrigatório", derr.Message())
+	}
+
+	if got := domainerror.Localize(err, domainerror.LocaleES); got != "El campo email es obligatorio" {
+		t.Errorf("Localize() = %v, want El campo email es obligatorio", got)
+	}
+
+	var upstream *UpstreamError
+	if !errors.As(err, &upstream) || upstream.Message != "Field email is required" {
+		t.Errorf("UpstreamError = %+v, want message Field email is required", upstream)
+	}
+}
+
+func TestDecodeResponse_OccurrenceMessageGoesToDetail(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		expectedDetail string
+	}{
+		{
+			name:           "Rendered template",
+			body:           `{"code":"FILE_TOO_LARGE","message":"Arquivo muito grande: máximo 10 MB"}`,
+			expectedDetail: "Arquivo muito grande: máximo 10 MB",
+		},
+		{
+			name:           "Translated definition",
+			body:           `{"code":"FILE_TOO_LARGE","message":"File too large"}`,
+			expectedDetail: "",
+		},
+		{
+			name:           "Explicit detail wins",
+			body:           `{"code":"FILE_TOO_LARGE","message":"Arquivo muito grande: máximo 10 MB","detail":"anexo.pdf tem 12 MB"}`,
+			expectedDetail: "anexo.pdf tem 12 MB",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var derr *domainerror.DomainError
+			if !errors.As(DecodeResponse(newResponse(http.StatusRequestEntityTooLarge, tt.body)), &derr) {
+				t.Fatalf("errors.As(err, *DomainError) = false, want true")
+			}
+
+			if derr.Message() != domainerror.ErrFileTooLarge.Message() || derr.Detail() != tt.expectedDetail {
+				t.Errorf("Message() = %v, Detail() = %v, want %v and %v", derr.Message(), derr.Detail(), domainerror.ErrFileTooLarge.Message(), tt.expectedDetail)
+			}
+		})
+	}
+}
+
+func TestDecodeResponse_RetryAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		retryAfter string
+		expected   time.Duration
+	}{
+		{name: "Seconds", statusCode: http.StatusTooManyRequests, body: `{"code":"RATE_LIMIT_EXCEEDED","message":"Limite de requisições excedido"}`, retryAfter: "30", expected: 30 * time.Second},
+		{name: "Unrecognized body", statusCode: http.StatusServiceUnavailable, body: `maintenance`, retryAfter: "120", expected: 2 * time.Minute},
+		{name: "Invalid value", statusCode: http.StatusTooManyRequests, body: `{"code":"RATE_LIMIT_EXCEEDED"}`, retryAfter: "soon", expected: 0},
+		{name: "Absent", statusCode: http.StatusTooManyRequests, body: `{"code":"RATE_LIMIT_EXCEEDED"}`, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := newResponse(tt.statusCode, tt.body)
+			resp.Header = http.Header{}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+
+			if got := domainerror.RetryAfter(DecodeResponse(resp)); got != tt.expected {
+				t.Errorf("RetryAfter() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	resp := newResponse(http.StatusServiceUnavailable, ``)
+	resp.Header = http.Header{"Retry-After": {time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)}}
+	if got := domainerror.RetryAfter(DecodeResponse(resp)); got < 59*time.Minute || got > time.Hour {
+		t.Errorf("RetryAfter() = %v, want about 1h from HTTP date", got)
+	}
+}
+
+func TestRoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ok" {
+			w.Write([]byte("ok"))
+			return
+		}
+		Write(w, r, domainerror.ErrForbidden)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRoundTripper(nil)}
+
+	resp, err := client.Get(server.URL + "/ok")
+	if err != nil {
+		t.Fatalf("Get(/ok) error = %v", err)
+	}
+	resp.Body.Close()
+
+	_, err = client.Get(server.URL + "/customers")
+	if !errors.Is(err, domainerror.ErrForbidden) {
+		t.Errorf("Get(/customers) error = %v, want ErrForbidden", err)
+	}
+}
@@ -0,0 +1,83 @@
+package httperror
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+// PanicError é a causa anexada a ErrInternalServer quando um handler entra em
+// pânico; fica acessível ao Logger via errors.As
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// GinMiddleware converte c.Errors e panics em respostas de erro com o Writer
+// padrão. Ver Writer.GinMiddleware
+func GinMiddleware() gin.HandlerFunc {
+	return defaultWriter.GinMiddleware()
+}
+
+// GinMiddleware roda depois dos handlers e, se nenhuma resposta foi escrita,
+// escreve o erro mais relevante de c.Errors. Panics viram ErrInternalServer
+// com um error_id nos details, para correlacionar a resposta com o log
+func (w *Writer) GinMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+
+			err := domainerror.ErrInternalServer.
+				WithDetails(map[string]any{"error_id": newErrorID()}).
+				WithCause(&PanicError{Value: rec, Stack: debug.Stack()})
+
+			c.Abort()
+			if !c.Writer.Written() {
+				w.WriteError(c, err)
+			}
+		}()
+
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		w.WriteError(c, mostRelevantError(c.Errors))
+	}
+}
+
+// mostRelevantError prefere o último erro de domínio registrado; sem nenhum,
+// usa o último erro
+func mostRelevantError(errs []*gin.Error) error {
+	for i := len(errs) - 1; i >= 0; i-- {
+		var derr *domainerror.DomainError
+		if errors.As(errs[i].Err, &derr) {
+			return errs[i].Err
+		}
+	}
+	return errs[len(errs)-1].Err
+}
+
+func newErrorID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
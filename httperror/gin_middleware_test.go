@@ -0,0 +1,139 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+func newGinEngine(middleware gin.HandlerFunc, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(middleware)
+	engine.GET("/", handler)
+	return engine
+}
+
+func TestGinMiddleware_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        gin.HandlerFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "Single domain error",
+			handler: func(c *gin.Context) {
+				c.Error(domainerror.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "NOT_FOUND",
+		},
+		{
+			name: "Domain error preferred over generic error",
+			handler: func(c *gin.Context) {
+				c.Error(domainerror.ErrConflict)
+				c.Error(errors.New("audit log failed"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "CONFLICT",
+		},
+		{
+			name: "Last domain error wins",
+			handler: func(c *gin.Context) {
+				c.Error(domainerror.ErrNotFound)
+				c.Error(domainerror.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   "FORBIDDEN",
+		},
+		{
+			name: "Generic error",
+			handler: func(c *gin.Context) {
+				c.Error(errors.New("boom"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "INTERNAL_SERVER_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			newGinEngine(GinMiddleware(), tt.handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("status = %v, want %v", rec.Code, tt.expectedStatus)
+			}
+
+			var body struct {
+				Code string `json:"code"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+
+			if body.Code != tt.expectedCode {
+				t.Errorf("code = %v, want %v", body.Code, tt.expectedCode)
+			}
+		})
+	}
+}
+
+func TestGinMiddleware_KeepsWrittenResponse(t *testing.T) {
+	rec := httptest.NewRecorder()
+	handler := func(c *gin.Context) {
+		c.Error(domainerror.ErrNotFound)
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	}
+
+	newGinEngine(GinMiddleware(), handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusOK)
+	}
+}
+
+func TestGinMiddleware_Panic(t *testing.T) {
+	var logged error
+	writer := NewWriter(WithLogger(func(r *http.Request, err error, status int) {
+		logged = err
+	}))
+
+	rec := httptest.NewRecorder()
+	handler := func(c *gin.Context) {
+		panic("nil map")
+	}
+
+	newGinEngine(writer.GinMiddleware(), handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusInternalServerError)
+	}
+
+	var body struct {
+		Code    string         `json:"code"`
+		Details map[string]any `json:"details"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if body.Code != domainerror.ErrInternalServer.Code() {
+		t.Errorf("code = %v, want %v", body.Code, domainerror.ErrInternalServer.Code())
+	}
+
+	if id, _ := body.Details["error_id"].(string); len(id) != 32 {
+		t.Errorf("error_id = %v, want 32 hex chars", body.Details["error_id"])
+	}
+
+	var panicErr *PanicError
+	if !errors.As(logged, &panicErr) || panicErr.Value != "nil map" {
+		t.Errorf("logged error = %v, want PanicError with value", logged)
+	}
+}
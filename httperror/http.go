@@ -0,0 +1,25 @@
+package httperror
+
+import "net/http"
+
+// HandlerFunc é um handler net/http que retorna erro em vez de escrevê-lo
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// ServeHTTP escreve o erro retornado pelo handler com o Writer padrão
+func (fn HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := fn(w, r); err != nil {
+		defaultWriter.Write(w, r, err)
+	}
+}
+
+// Write escreve o erro com o Writer padrão, para serviços net/http e chi.
+// Ver Writer.Write
+func Write(w http.ResponseWriter, r *http.Request, err error) {
+	defaultWriter.Write(w, r, err)
+}
+
+// Handler adapta um handler que retorna erro para http.Handler usando o
+// Writer padrão
+func Handler(fn HandlerFunc) http.Handler {
+	return defaultWriter.Handler(fn)
+}
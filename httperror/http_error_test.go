@@ -0,0 +1,142 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+func TestDefaultHTTPStatusMapper_Status(t *testing.T) {
+	mapper := NewDefaultHTTPStatusMapper()
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{
+			name:           "Nil error returns 200",
+			err:            nil,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Not found returns 404",
+			err:            domainerror.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Validation errors return 400",
+			err:            domainerror.NewValidationErrors().AddError("/email", domainerror.ErrInvalidEmail),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unknown error returns 500",
+			err:            errors.New("boom"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status := mapper.Status(tt.err); status != tt.expectedStatus {
+				t.Errorf("Status() = %v, want %v", status, tt.expectedStatus)
+			}
+		})
+	}
+}
+
+func TestDefaultHTTPStatusMapper_MatchesDomainMapper(t *testing.T) {
+	mapper := NewDefaultHTTPStatusMapper()
+	domainMapper := domainerror.NewHTTPStatusMapper()
+
+	errs := []*domainerror.DomainError{
+		domainerror.ErrRecordLocked,
+		domainerror.ErrInsufficientBalance,
+		domainerror.ErrAccountSuspended,
+		domainerror.ErrExternalServiceUnavailable,
+		domainerror.ErrConcurrentModification,
+	}
+
+	for _, err := range errs {
+		t.Run(err.Code(), func(t *testing.T) {
+			if got, want := mapper.Status(err), domainMapper.GetHTTPStatus(err); got != want {
+				t.Errorf("Status() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWriteError_Violations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/customers", nil)
+
+	err := domainerror.NewValidationErrors().
+		AddError("/document/cpf", domainerror.ErrInvalidCPF).
+		AddError("/email", domainerror.ErrInvalidEmail)
+
+	WriteError(c, err)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusBadRequest)
+	}
+
+	var body struct {
+		Code       string                  `json:"code"`
+		Violations []domainerror.Violation `json:"violations"`
+	}
+	if decodeErr := json.Unmarshal(rec.Body.Bytes(), &body); decodeErr != nil {
+		t.Fatalf("json.Unmarshal() error = %v", decodeErr)
+	}
+
+	if body.Code != domainerror.ErrInvalidInput.Code() {
+		t.Errorf("code = %v, want %v", body.Code, domainerror.ErrInvalidInput.Code())
+	}
+
+	if len(body.Violations) != 2 {
+		t.Errorf("violations = %v, want 2 items", body.Violations)
+	}
+}
+
+func TestWriteError_ContextWithoutRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		write        func(c *gin.Context, err error)
+		expectedBody string
+	}{
+		{
+			name:         "WriteError",
+			write:        WriteError,
+			expectedBody: `{"code":"NOT_FOUND","message":"Registro não encontrado"}`,
+		},
+		{
+			name:         "WriteProblem",
+			write:        WriteProblem,
+			expectedBody: `{"type":"about:blank","title":"Registro não encontrado","status":404,"code":"NOT_FOUND"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+
+			tt.write(c, domainerror.ErrNotFound)
+
+			if rec.Code != http.StatusNotFound {
+				t.Errorf("status = %v, want %v", rec.Code, http.StatusNotFound)
+			}
+			if got := rec.Body.String(); got != tt.expectedBody {
+				t.Errorf("body = %v, want %v", got, tt.expectedBody)
+			}
+		})
+	}
+}
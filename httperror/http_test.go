@@ -0,0 +1,83 @@
+package httperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+func TestWrite(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/customers/42", nil)
+
+	Write(rec, req, fmt.Errorf("service: %w", domainerror.ErrNotFound))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusNotFound)
+	}
+
+	if got := rec.Header().Get("Content-Type"); got != "application/json; charset=utf-8" {
+		t.Errorf("Content-Type = %v, want application/json; charset=utf-8", got)
+	}
+
+	expected := `{"code":"NOT_FOUND","message":"Registro não encontrado"}`
+	if got := rec.Body.String(); got != expected {
+		t.Errorf("body = %v, want %v", got, expected)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        HandlerFunc
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success response is untouched",
+			handler: func(w http.ResponseWriter, r *http.Request) error {
+				w.WriteHeader(http.StatusNoContent)
+				return nil
+			},
+			expectedStatus: http.StatusNoContent,
+			expectedBody:   "",
+		},
+		{
+			name: "Domain error is written",
+			handler: func(w http.ResponseWriter, r *http.Request) error {
+				return domainerror.ErrForbidden
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"code":"FORBIDDEN","message":"Acesso negado"}`,
+		},
+		{
+			name: "Unknown error becomes internal server error",
+			handler: func(w http.ResponseWriter, r *http.Request) error {
+				return errors.New("boom")
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"code":"INTERNAL_SERVER_ERROR","message":"Erro interno do servidor"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, h := range []http.Handler{tt.handler, Handler(tt.handler)} {
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+				if rec.Code != tt.expectedStatus {
+					t.Errorf("status = %v, want %v", rec.Code, tt.expectedStatus)
+				}
+
+				if got := rec.Body.String(); got != tt.expectedBody {
+					t.Errorf("body = %v, want %v", got, tt.expectedBody)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package httperror
+
+import (
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+// format é a representação escolhida para o corpo de erro
+type format int
+
+const (
+	formatNone format = iota
+	formatJSON
+	formatProblem
+	formatXML
+	formatText
+)
+
+// mediaTypes associa os media types aceitos ao formato de resposta
+var mediaTypes = map[string]format{
+	"*/*":                      formatJSON,
+	"application/*":            formatJSON,
+	"application/json":         formatJSON,
+	"application/problem+json": formatProblem,
+	"application/xml":          formatXML,
+	"text/xml":                 formatXML,
+	"text/*":                   formatText,
+	"text/plain":               formatText,
+}
+
+type acceptRange struct {
+	mediaType string
+	quality   float64
+}
+
+type languageRange struct {
+	tag     string
+	quality float64
+}
+
+// negotiate escolhe o formato a partir do header Accept. Sem header, o formato
+// é JSON; se nenhum media range for suportado, retorna formatNone
+func negotiate(accept string) format {
+	if strings.TrimSpace(accept) == "" {
+		return formatJSON
+	}
+
+	ranges := parseAccept(accept)
+	for _, r := range ranges {
+		if f, ok := mediaTypes[r.mediaType]; ok {
+			return f
+		}
+	}
+	return formatNone
+}
+
+// parseAccept retorna os media ranges ordenados por qualidade, descartando q=0
+func parseAccept(accept string) []acceptRange {
+	var ranges []acceptRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if parsed, parseErr := strconv.ParseFloat(q, 64); parseErr == nil {
+				quality = parsed
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		ranges = append(ranges, acceptRange{mediaType: mediaType, quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	return ranges
+}
+
+// negotiateLanguage escolhe o locale do catálogo a partir do header
+// Accept-Language. Sem header ou sem idioma suportado, usa DefaultLocale
+func negotiateLanguage(acceptLanguage string, catalog *domainerror.Catalog) string {
+	for _, r := range parseAcceptLanguage(acceptLanguage) {
+		if r.tag == "*" {
+			break
+		}
+		if locale, ok := catalog.Match(r.tag); ok {
+			return locale
+		}
+	}
+	return domainerror.DefaultLocale
+}
+
+// parseAcceptLanguage retorna as language tags ordenadas por qualidade,
+// descartando q=0
+func parseAcceptLanguage(acceptLanguage string) []languageRange {
+	var ranges []languageRange
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, parseErr := strconv.ParseFloat(q, 64); parseErr == nil {
+				quality = parsed
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		ranges = append(ranges, languageRange{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	return ranges
+}
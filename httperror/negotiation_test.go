@@ -0,0 +1,222 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name     string
+		accept   string
+		expected format
+	}{
+		{name: "Empty header", accept: "", expected: formatJSON},
+		{name: "Any", accept: "*/*", expected: formatJSON},
+		{name: "JSON", accept: "application/json", expected: formatJSON},
+		{name: "Problem JSON", accept: "application/problem+json", expected: formatProblem},
+		{name: "XML", accept: "application/xml", expected: formatXML},
+		{name: "Text XML", accept: "text/xml; charset=utf-8", expected: formatXML},
+		{name: "Plain text", accept: "text/plain", expected: formatText},
+		{name: "Quality ordering", accept: "application/json;q=0.5, application/xml", expected: formatXML},
+		{name: "Browser header", accept: "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", expected: formatXML},
+		{name: "Unsupported skipped", accept: "text/html, application/json", expected: formatJSON},
+		{name: "Explicitly refused", accept: "application/json;q=0", expected: formatNone},
+		{name: "Nothing supported", accept: "image/png", expected: formatNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := negotiate(tt.accept); got != tt.expected {
+				t.Errorf("negotiate(%q) = %v, want %v", tt.accept, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWriteError_ContentNegotiation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                string
+		accept              string
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:                "JSON by default",
+			accept:              "",
+			expectedStatus:      http.StatusNotFound,
+			expectedContentType: "application/json",
+			expectedBody:        `{"code":"NOT_FOUND","message":"Registro não encontrado"}`,
+		},
+		{
+			name:                "Problem JSON",
+			accept:              "application/problem+json",
+			expectedStatus:      http.StatusNotFound,
+			expectedContentType: ProblemContentType,
+			expectedBody:        `"title":"Registro não encontrado"`,
+		},
+		{
+			name:                "XML",
+			accept:              "application/xml",
+			expectedStatus:      http.StatusNotFound,
+			expectedContentType: "application/xml",
+			expectedBody:        "<error><code>NOT_FOUND</code><message>Registro não encontrado</message></error>",
+		},
+		{
+			name:                "Plain text",
+			accept:              "text/plain",
+			expectedStatus:      http.StatusNotFound,
+			expectedContentType: "text/plain",
+			expectedBody:        "NOT_FOUND: Registro não encontrado",
+		},
+		{
+			name:                "Not acceptable",
+			accept:              "image/png",
+			expectedStatus:      http.StatusNotAcceptable,
+			expectedContentType: "application/json",
+			expectedBody:        `{"code":"NOT_ACCEPTABLE","message":"Formato de resposta não suportado"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = httptest.NewRequest(http.MethodGet, "/customers/42", nil)
+			c.Request.Header.Set("Accept", tt.accept)
+
+			WriteError(c, domainerror.ErrNotFound)
+
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("status = %v, want %v", rec.Code, tt.expectedStatus)
+			}
+
+			if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, tt.expectedContentType) {
+				t.Errorf("Content-Type = %v, want %v", got, tt.expectedContentType)
+			}
+
+			if got := rec.Body.String(); !strings.Contains(got, tt.expectedBody) {
+				t.Errorf("body = %v, want %v", got, tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestWriteError_XMLViolations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/customers", nil)
+	c.Request.Header.Set("Accept", "application/xml")
+
+	err := domainerror.NewValidationErrors().
+		Add("/name", "REQUIRED_FIELD", "Nome obrigatório", map[string]any{"min": 3})
+
+	WriteError(c, err)
+
+	expected := "<violations><violation><path>/name</path><code>REQUIRED_FIELD</code>" +
+		"<message>Nome obrigatório</message><params><param key=\"min\">3</param></params></violation></violations>"
+	if got := rec.Body.String(); !strings.Contains(got, expected) {
+		t.Errorf("body = %v, want %v", got, expected)
+	}
+}
+
+func TestNegotiateLanguage(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       string
+	}{
+		{name: "Empty header", acceptLanguage: "", expected: domainerror.LocalePtBR},
+		{name: "English", acceptLanguage: "en-US,en;q=0.9", expected: domainerror.LocaleEN},
+		{name: "Spanish", acceptLanguage: "es", expected: domainerror.LocaleES},
+		{name: "Quality ordering", acceptLanguage: "en;q=0.5, es;q=0.8", expected: domainerror.LocaleES},
+		{name: "Unsupported skipped", acceptLanguage: "fr-FR, en;q=0.7", expected: domainerror.LocaleEN},
+		{name: "Explicitly refused", acceptLanguage: "en;q=0", expected: domainerror.LocalePtBR},
+		{name: "Wildcard", acceptLanguage: "fr, *", expected: domainerror.LocalePtBR},
+		{name: "Nothing supported", acceptLanguage: "de-DE", expected: domainerror.LocalePtBR},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := negotiateLanguage(tt.acceptLanguage, domainerror.DefaultCatalog()); got != tt.expected {
+				t.Errorf("negotiateLanguage(%q) = %v, want %v", tt.acceptLanguage, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWriter_AcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name             string
+		acceptLanguage   string
+		accept           string
+		err              error
+		expectedLanguage string
+		expectedBody     string
+	}{
+		{
+			name:             "Portuguese by default",
+			err:              domainerror.ErrNotFound,
+			expectedLanguage: "pt-BR",
+			expectedBody:     `{"code":"NOT_FOUND","message":"Registro não encontrado"}`,
+		},
+		{
+			name:             "English",
+			acceptLanguage:   "en-US",
+			err:              domainerror.ErrNotFound,
+			expectedLanguage: "en",
+			expectedBody:     `{"code":"NOT_FOUND","message":"Record not found"}`,
+		},
+		{
+			name:             "Spanish text",
+			acceptLanguage:   "es",
+			accept:           "text/plain",
+			err:              domainerror.ErrPaymentOverdue,
+			expectedLanguage: "es",
+			expectedBody:     "PAYMENT_OVERDUE: Pago atrasado",
+		},
+		{
+			name:             "English violations",
+			acceptLanguage:   "en",
+			err:              domainerror.NewValidationErrors().AddError("/email", domainerror.ErrRequiredField.WithField("email")),
+			expectedLanguage: "en",
+			expectedBody: `{"code":"INVALID_INPUT","message":"Invalid input","violations":[` +
+				`{"path":"/email","code":"REQUIRED_FIELD","message":"Field email is required","params":{"field":"email"}}]}`,
+		},
+		{
+			name:             "Unknown error in English",
+			acceptLanguage:   "en",
+			err:              errors.New("pq: password authentication failed"),
+			expectedLanguage: "en",
+			expectedBody:     `{"code":"INTERNAL_SERVER_ERROR","message":"Internal server error"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/customers/42", nil)
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
+			req.Header.Set("Accept", tt.accept)
+
+			NewWriter().Write(rec, req, tt.err)
+
+			if got := rec.Header().Get("Content-Language"); got != tt.expectedLanguage {
+				t.Errorf("Content-Language = %v, want %v", got, tt.expectedLanguage)
+			}
+			if got := rec.Body.String(); got != tt.expectedBody {
+				t.Errorf("body = %v, want %v", got, tt.expectedBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package httperror
+
+import (
+	"strings"
+
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+// ProblemContentType é o media type definido pela RFC 9457
+const ProblemContentType = "application/problem+json"
+
+// Problem representa um corpo problem+json (RFC 9457). Code, Field, Details e
+// Violations são membros de extensão
+type Problem struct {
+	Type       string                  `json:"type"`
+	Title      string                  `json:"title"`
+	Status     int                     `json:"status"`
+	Detail     string                  `json:"detail,omitempty"`
+	Instance   string                  `json:"instance,omitempty"`
+	Code       string                  `json:"code"`
+	Field      string                  `json:"field,omitempty"`
+	Details    map[string]any          `json:"details,omitempty"`
+	Violations []domainerror.Violation `json:"violations,omitempty"`
+}
+
+// ProblemTypes resolve a URI do membro "type" de cada código de erro
+type ProblemTypes struct {
+	baseURI string
+	byCode  map[string]string
+}
+
+// NewProblemTypes cria um resolver que, para códigos sem URI explícita, usa
+// baseURI + código em kebab-case (ex: https://errors.example.com/not-found).
+// Sem baseURI, o type padrão é "about:blank"
+func NewProblemTypes(baseURI string) *ProblemTypes {
+	return &ProblemTypes{
+		baseURI: baseURI,
+		byCode:  make(map[string]string),
+	}
+}
+
+// Set define a URI de type para um código específico
+func (t *ProblemTypes) Set(code, uri string) *ProblemTypes {
+	t.byCode[code] = uri
+	return t
+}
+
+// URI retorna a URI de type para o código informado
+func (t *ProblemTypes) URI(code string) string {
+	if uri, ok := t.byCode[code]; ok {
+		return uri
+	}
+	if t.baseURI == "" {
+		return "about:blank"
+	}
+	return strings.TrimSuffix(t.baseURI, "/") + "/" + strings.ToLower(strings.ReplaceAll(code, "_", "-"))
+}
+
+// NewProblem monta o corpo problem+json para o erro. O title é a mensagem
+// genérica do código (DomainError.Title), que não muda entre ocorrências; a
+// mensagem da ocorrência, quando difere dela, vai em detail se o erro não tiver
+// Detail. Erros que não são de domínio são tratados como ErrInternalServer, sem
+// expor a mensagem original
+func NewProblem(err error, status int, instance string, types *ProblemTypes) *Problem {
+	derr := domainErrorOf(err)
+
+	detail := derr.Detail()
+	if detail == "" && derr.Message() != derr.Title() {
+		detail = derr.Message()
+	}
+
+	return &Problem{
+		Type:       types.URI(derr.Code()),
+		Title:      derr.Title(),
+		Status:     status,
+		Detail:     detail,
+		Instance:   instance,
+		Code:       derr.Code(),
+		Field:      derr.Field(),
+		Details:    derr.Details(),
+		Violations: violationsOf(err),
+	}
+}
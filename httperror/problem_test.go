@@ -0,0 +1,160 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+func TestProblemTypes_URI(t *testing.T) {
+	types := NewProblemTypes("https://errors.example.com/").
+		Set(domainerror.ErrNotFound.Code(), "https://docs.example.com/not-found")
+
+	tests := []struct {
+		name     string
+		types    *ProblemTypes
+		code     string
+		expected string
+	}{
+		{
+			name:     "Explicit URI",
+			types:    types,
+			code:     "NOT_FOUND",
+			expected: "https://docs.example.com/not-found",
+		},
+		{
+			name:     "Base URI with kebab-case code",
+			types:    types,
+			code:     "DUPLICATE_EMAIL",
+			expected: "https://errors.example.com/duplicate-email",
+		},
+		{
+			name:     "Without base URI",
+			types:    NewProblemTypes(""),
+			code:     "DUPLICATE_EMAIL",
+			expected: "about:blank",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.types.URI(tt.code); got != tt.expected {
+				t.Errorf("URI() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewProblem_NonDomainError(t *testing.T) {
+	problem := NewProblem(errors.New("secret"), http.StatusInternalServerError, "/users", NewProblemTypes(""))
+
+	if problem.Code != domainerror.ErrInternalServer.Code() {
+		t.Errorf("Code = %v, want %v", problem.Code, domainerror.ErrInternalServer.Code())
+	}
+
+	if problem.Title != domainerror.ErrInternalServer.Message() {
+		t.Errorf("Title = %v, want %v", problem.Title, domainerror.ErrInternalServer.Message())
+	}
+}
+
+func TestNewProblem_TitleIsStableAcrossOccurrences(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedTitle  string
+		expectedDetail string
+	}{
+		{
+			name:           "Template rendered in detail",
+			err:            domainerror.ErrRequiredField.WithField("email"),
+			expectedTitle:  "Campo obrigatório não informado",
+			expectedDetail: "Campo email obrigatório",
+		},
+		{
+			name:           "Custom message in detail",
+			err:            domainerror.ErrNotFound.WithMessage("Cliente 42 não existe"),
+			expectedTitle:  "Registro não encontrado",
+			expectedDetail: "Cliente 42 não existe",
+		},
+		{
+			name:           "Explicit detail wins",
+			err:            domainerror.ErrRequiredField.WithField("email").WithDetail("informe o email do contato"),
+			expectedTitle:  "Campo obrigatório não informado",
+			expectedDetail: "informe o email do contato",
+		},
+		{
+			name:           "Localized title",
+			err:            domainerror.LocalizeError(domainerror.ErrFileTooLarge.WithParam("max", 10), domainerror.LocaleEN),
+			expectedTitle:  "File too large",
+			expectedDetail: "File too large: maximum 10 MB",
+		},
+		{
+			name:           "Localized title with custom message",
+			err:            domainerror.LocalizeError(domainerror.ErrNotFound.WithMessage("Cliente 42 não existe"), domainerror.LocaleEN),
+			expectedTitle:  "Record not found",
+			expectedDetail: "Cliente 42 não existe",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problem := NewProblem(tt.err, http.StatusBadRequest, "", NewProblemTypes(""))
+
+			if problem.Title != tt.expectedTitle {
+				t.Errorf("Title = %v, want %v", problem.Title, tt.expectedTitle)
+			}
+			if problem.Detail != tt.expectedDetail {
+				t.Errorf("Detail = %v, want %v", problem.Detail, tt.expectedDetail)
+			}
+		})
+	}
+}
+
+func TestWriteProblem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/customers/42", nil)
+
+	err := domainerror.ErrNotFound.
+		WithDetail("cliente 42 não encontrado").
+		WithDetails(map[string]any{"id": "42"})
+
+	WriteProblem(c, err)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusNotFound)
+	}
+
+	if got := rec.Header().Get("Content-Type"); got != ProblemContentType {
+		t.Errorf("Content-Type = %v, want %v", got, ProblemContentType)
+	}
+
+	var body map[string]any
+	if decodeErr := json.Unmarshal(rec.Body.Bytes(), &body); decodeErr != nil {
+		t.Fatalf("json.Unmarshal() error = %v", decodeErr)
+	}
+
+	expected := map[string]any{
+		"type":     "about:blank",
+		"title":    "Registro não encontrado",
+		"status":   float64(http.StatusNotFound),
+		"detail":   "cliente 42 não encontrado",
+		"instance": "/customers/42",
+		"code":     "NOT_FOUND",
+	}
+	for key, want := range expected {
+		if body[key] != want {
+			t.Errorf("%s = %v, want %v", key, body[key], want)
+		}
+	}
+
+	if details, _ := body["details"].(map[string]any); details["id"] != "42" {
+		t.Errorf("details = %v, want id 42", body["details"])
+	}
+}
@@ -0,0 +1,119 @@
+package httperror
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+// xmlErrorBody é a representação XML do corpo {code, message}
+type xmlErrorBody struct {
+	XMLName    xml.Name       `xml:"error"`
+	Code       string         `xml:"code"`
+	Message    string         `xml:"message"`
+	Detail     string         `xml:"detail,omitempty"`
+	Field      string         `xml:"field,omitempty"`
+	Details    *xmlEntries    `xml:"details,omitempty"`
+	Violations *xmlViolations `xml:"violations,omitempty"`
+}
+
+type xmlEntries struct {
+	Entries []xmlEntry `xml:"entry"`
+}
+
+type xmlEntry struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xmlViolations struct {
+	Items []xmlViolation `xml:"violation"`
+}
+
+type xmlViolation struct {
+	Path    string     `xml:"path"`
+	Code    string     `xml:"code"`
+	Message string     `xml:"message"`
+	Params  []xmlEntry `xml:"params>param,omitempty"`
+}
+
+// domainErrorOf extrai o erro de domínio; erros desconhecidos viram
+// ErrInternalServer para não expor detalhes internos
+func domainErrorOf(err error) *domainerror.DomainError {
+	derr := domainerror.ErrInternalServer
+	errors.As(err, &derr)
+	return derr
+}
+
+// violationsOf retorna as violações de campo quando err é ValidationErrors
+func violationsOf(err error) []domainerror.Violation {
+	var verrs *domainerror.ValidationErrors
+	if errors.As(err, &verrs) {
+		return verrs.Violations()
+	}
+	return nil
+}
+
+func newXMLErrorBody(err error) *xmlErrorBody {
+	derr := domainErrorOf(err)
+
+	body := &xmlErrorBody{
+		Code:    derr.Code(),
+		Message: derr.Message(),
+		Detail:  derr.Detail(),
+		Field:   derr.Field(),
+	}
+
+	if details := derr.Details(); len(details) > 0 {
+		body.Details = &xmlEntries{Entries: sortedEntries(details)}
+	}
+
+	if violations := violationsOf(err); len(violations) > 0 {
+		body.Violations = &xmlViolations{}
+		for _, v := range violations {
+			body.Violations.Items = append(body.Violations.Items, xmlViolation{
+				Path:    v.Path,
+				Code:    v.Code,
+				Message: v.Message,
+				Params:  sortedEntries(v.Params),
+			})
+		}
+	}
+
+	return body
+}
+
+// sortedEntries converte um map em entradas ordenadas por chave, já que
+// encoding/xml não serializa maps
+func sortedEntries(values map[string]any) []xmlEntry {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	entries := make([]xmlEntry, 0, len(keys))
+	for _, k := range keys {
+		entries = append(entries, xmlEntry{Key: k, Value: fmt.Sprint(values[k])})
+	}
+	return entries
+}
+
+// textErrorBody retorna "CODE: mensagem", seguido de uma linha por violação
+func textErrorBody(err error) string {
+	derr := domainErrorOf(err)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s", derr.Code(), derr.Message())
+	if derr.Detail() != "" {
+		fmt.Fprintf(&b, " (%s)", derr.Detail())
+	}
+	for _, v := range violationsOf(err) {
+		fmt.Fprintf(&b, "\n%s: %s: %s", v.Path, v.Code, v.Message)
+	}
+	return b.String()
+}
@@ -0,0 +1,241 @@
+package httperror
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+// BodyBuilder monta o corpo JSON (application/json) da resposta de erro
+type BodyBuilder func(err error, status int) any
+
+// Logger é chamado para cada erro escrito, com o status já resolvido
+type Logger func(r *http.Request, err error, status int)
+
+// DefaultRetryAfter é a sugestão de Retry-After para respostas 429 e 503 cujo
+// erro não informa RetryAfter
+const DefaultRetryAfter = 5 * time.Second
+
+// HeaderHook pode adicionar headers à resposta antes de ela ser escrita
+type HeaderHook func(h http.Header, err error, status int)
+
+// Writer escreve erros de domínio como respostas HTTP, via net/http (Write,
+// Handler) ou gin (WriteError, WriteProblem). Cada serviço pode montar o seu
+// com NewWriter; as funções do pacote usam um Writer padrão
+type Writer struct {
+	mapper       HTTPStatusMapper
+	bodyBuilder  BodyBuilder
+	problemTypes *ProblemTypes
+	logger       Logger
+	headerHooks  []HeaderHook
+	retryAfter   time.Duration
+	catalog      *domainerror.Catalog
+}
+
+// Option configura um Writer
+type Option func(*Writer)
+
+// WithStatusMapper substitui o mapper de status HTTP
+func WithStatusMapper(mapper HTTPStatusMapper) Option {
+	return func(w *Writer) {
+		w.mapper = mapper
+	}
+}
+
+// WithBodyBuilder substitui o corpo das respostas application/json
+func WithBodyBuilder(builder BodyBuilder) Option {
+	return func(w *Writer) {
+		w.bodyBuilder = builder
+	}
+}
+
+// WithProblemTypes configura as URIs de type das respostas problem+json
+func WithProblemTypes(types *ProblemTypes) Option {
+	return func(w *Writer) {
+		w.problemTypes = types
+	}
+}
+
+// WithLogger registra um hook chamado para cada erro escrito
+func WithLogger(logger Logger) Option {
+	return func(w *Writer) {
+		w.logger = logger
+	}
+}
+
+// WithHeaderHook adiciona um hook de headers; os hooks rodam na ordem em que
+// foram adicionados
+func WithHeaderHook(hook HeaderHook) Option {
+	return func(w *Writer) {
+		w.headerHooks = append(w.headerHooks, hook)
+	}
+}
+
+// WithRetryAfter substitui a sugestão de Retry-After usada em respostas 429 e
+// 503 quando o erro não informa a sua; 0 desliga o header nesses casos
+func WithRetryAfter(d time.Duration) Option {
+	return func(w *Writer) {
+		w.retryAfter = d
+	}
+}
+
+// WithCatalog substitui o catálogo usado para traduzir as mensagens conforme
+// o header Accept-Language
+func WithCatalog(catalog *domainerror.Catalog) Option {
+	return func(w *Writer) {
+		w.catalog = catalog
+	}
+}
+
+// NewWriter cria um Writer com o mapper padrão, o corpo {code, message},
+// type "about:blank" para problem+json e o catálogo de mensagens padrão,
+// aplicando as opções informadas
+func NewWriter(opts ...Option) *Writer {
+	w := &Writer{
+		mapper:       NewDefaultHTTPStatusMapper(),
+		bodyBuilder:  DefaultBody,
+		problemTypes: NewProblemTypes(""),
+		retryAfter:   DefaultRetryAfter,
+		catalog:      domainerror.DefaultCatalog(),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+var defaultWriter = NewWriter()
+
+// DefaultBody é o corpo JSON padrão: o próprio erro de domínio, as violações de
+// ValidationErrors ou ErrInternalServer para erros desconhecidos
+func DefaultBody(err error, status int) any {
+	var verrs *domainerror.ValidationErrors
+	if errors.As(err, &verrs) {
+		return verrs
+	}
+	return domainErrorOf(err)
+}
+
+// Write escreve o erro no formato pedido pelo header Accept: JSON (padrão),
+// problem+json, XML ou texto. Se nenhum formato for aceito, responde
+// ErrNotAcceptable em JSON. As mensagens seguem o idioma do header
+// Accept-Language, com domainerror.DefaultLocale como padrão. Com err nil nada
+// é escrito; r nil é tratado como requisição sem headers
+func (w *Writer) Write(rw http.ResponseWriter, r *http.Request, err error) {
+	w.write(rw, r, err, negotiate(requestHeader(r, "Accept")))
+}
+
+// Handler adapta um handler que retorna erro para http.Handler, escrevendo o
+// erro retornado com este Writer. O handler não deve ter escrito a resposta
+// quando retorna erro
+func (w *Writer) Handler(fn HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if err := fn(rw, r); err != nil {
+			w.Write(rw, r, err)
+		}
+	})
+}
+
+func (w *Writer) write(rw http.ResponseWriter, r *http.Request, err error, f format) {
+	if err == nil {
+		return
+	}
+
+	status := w.mapper.Status(err)
+	if w.logger != nil {
+		w.logger(r, err, status)
+	}
+
+	if f == formatNone {
+		err = domainerror.ErrNotAcceptable
+		status = w.mapper.Status(err)
+		f = formatJSON
+	}
+
+	locale := negotiateLanguage(requestHeader(r, "Accept-Language"), w.catalog)
+	err = w.catalog.LocalizeError(err, locale)
+	rw.Header().Set("Content-Language", locale)
+
+	w.setRetryAfter(rw.Header(), err, status)
+	for _, hook := range w.headerHooks {
+		hook(rw.Header(), err, status)
+	}
+
+	var (
+		contentType string
+		body        []byte
+		encodeErr   error
+	)
+	switch f {
+	case formatProblem:
+		contentType = ProblemContentType
+		body, encodeErr = json.Marshal(NewProblem(err, status, requestPath(r), w.problemTypes))
+	case formatXML:
+		contentType = "application/xml; charset=utf-8"
+		body, encodeErr = xml.Marshal(newXMLErrorBody(err))
+	case formatText:
+		contentType = "text/plain; charset=utf-8"
+		body = []byte(textErrorBody(err))
+	default:
+		contentType = "application/json; charset=utf-8"
+		body, encodeErr = json.Marshal(w.bodyBuilder(err, status))
+	}
+
+	if encodeErr != nil {
+		rw.WriteHeader(status)
+		return
+	}
+
+	rw.Header().Set("Content-Type", contentType)
+	rw.WriteHeader(status)
+	rw.Write(body)
+}
+
+// setRetryAfter preenche Retry-After nas respostas 429 e 503. Ver RetryAfterHeader
+func (w *Writer) setRetryAfter(h http.Header, err error, status int) {
+	if value := RetryAfterHeader(err, status, w.retryAfter); value != "" {
+		h.Set("Retry-After", value)
+	}
+}
+
+// RetryAfterHeader retorna o valor de Retry-After (em segundos) para a resposta
+// de erro: nas respostas 429 e 503, o RetryAfter do erro ou, sem ele, fallback.
+// Vazio nos demais status ou quando não há sugestão
+func RetryAfterHeader(err error, status int, fallback time.Duration) string {
+	if status != http.StatusTooManyRequests && status != http.StatusServiceUnavailable {
+		return ""
+	}
+
+	d := domainerror.RetryAfter(err)
+	if d <= 0 {
+		d = fallback
+	}
+	if d <= 0 {
+		return ""
+	}
+
+	seconds := int64((d + time.Second - 1) / time.Second)
+	return strconv.FormatInt(seconds, 10)
+}
+
+// requestHeader lê o header da requisição; sem requisição (ex: contexto gin de
+// teste), o header é considerado ausente
+func requestHeader(r *http.Request, key string) string {
+	if r == nil {
+		return ""
+	}
+	return r.Header.Get(key)
+}
+
+// requestPath é o path usado como instance do problem+json; vazio sem requisição
+func requestPath(r *http.Request) string {
+	if r == nil || r.URL == nil {
+		return ""
+	}
+	return r.URL.Path
+}
@@ -0,0 +1,179 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+type fixedStatusMapper int
+
+func (m fixedStatusMapper) Status(err error) int {
+	return int(m)
+}
+
+func newGinContext(method, path, accept string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(method, path, nil)
+	if accept != "" {
+		c.Request.Header.Set("Accept", accept)
+	}
+	return c, rec
+}
+
+func TestWriter_Options(t *testing.T) {
+	var (
+		loggedErr    error
+		loggedStatus int
+	)
+
+	writer := NewWriter(
+		WithStatusMapper(fixedStatusMapper(http.StatusTeapot)),
+		WithBodyBuilder(func(err error, status int) any {
+			return map[string]any{"error": domainErrorOf(err).Code(), "status": status}
+		}),
+		WithLogger(func(r *http.Request, err error, status int) {
+			loggedErr = err
+			loggedStatus = status
+		}),
+		WithHeaderHook(func(h http.Header, err error, status int) {
+			h.Set("X-Error-Code", domainErrorOf(err).Code())
+		}),
+	)
+
+	c, rec := newGinContext(http.MethodGet, "/customers/42", "")
+	writer.WriteError(c, domainerror.ErrNotFound)
+
+	if rec.Code != http.StatusTeapot {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusTeapot)
+	}
+
+	expected := `{"error":"NOT_FOUND","status":418}`
+	if got := rec.Body.String(); got != expected {
+		t.Errorf("body = %v, want %v", got, expected)
+	}
+
+	if got := rec.Header().Get("X-Error-Code"); got != "NOT_FOUND" {
+		t.Errorf("X-Error-Code = %v, want NOT_FOUND", got)
+	}
+
+	if !errors.Is(loggedErr, domainerror.ErrNotFound) || loggedStatus != http.StatusTeapot {
+		t.Errorf("logger got %v, %v, want ErrNotFound, %v", loggedErr, loggedStatus, http.StatusTeapot)
+	}
+}
+
+func TestWriter_ProblemTypes(t *testing.T) {
+	writer := NewWriter(WithProblemTypes(NewProblemTypes("https://errors.example.com")))
+
+	c, rec := newGinContext(http.MethodGet, "/customers/42", "")
+	writer.WriteProblem(c, domainerror.ErrNotFound)
+
+	if got := rec.Body.String(); !strings.Contains(got, `"type":"https://errors.example.com/not-found"`) {
+		t.Errorf("body = %v, want type https://errors.example.com/not-found", got)
+	}
+}
+
+func TestWriter_UnknownErrorDoesNotLeak(t *testing.T) {
+	c, rec := newGinContext(http.MethodGet, "/customers/42", "")
+	NewWriter().WriteError(c, errors.New("pq: password authentication failed"))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusInternalServerError)
+	}
+
+	expected := `{"code":"INTERNAL_SERVER_ERROR","message":"Erro interno do servidor"}`
+	if got := rec.Body.String(); got != expected {
+		t.Errorf("body = %v, want %v", got, expected)
+	}
+}
+
+func TestWriter_NilRequest(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewWriter().Write(rec, nil, domainerror.ErrNotFound)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusNotFound)
+	}
+
+	expected := `{"code":"NOT_FOUND","message":"Registro não encontrado"}`
+	if got := rec.Body.String(); got != expected {
+		t.Errorf("body = %v, want %v", got, expected)
+	}
+}
+
+func TestWriter_NilErrorWritesNothing(t *testing.T) {
+	logged := false
+	writer := NewWriter(WithLogger(func(r *http.Request, err error, status int) {
+		logged = true
+	}))
+
+	rec := httptest.NewRecorder()
+	writer.Write(rec, httptest.NewRequest(http.MethodGet, "/customers/42", nil), nil)
+
+	if rec.Body.Len() != 0 || len(rec.Header()) != 0 {
+		t.Errorf("Write(nil) wrote a response: headers %v, body %q", rec.Header(), rec.Body.String())
+	}
+
+	if logged {
+		t.Errorf("Write(nil) called the logger")
+	}
+}
+
+func TestWriter_RetryAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		writer   *Writer
+		err      error
+		expected string
+	}{
+		{
+			name:     "Rate limit with hint",
+			writer:   NewWriter(),
+			err:      domainerror.ErrRateLimitExceeded.WithRetryAfter(1500 * time.Millisecond),
+			expected: "2",
+		},
+		{
+			name:     "Service unavailable without hint",
+			writer:   NewWriter(),
+			err:      domainerror.ErrDatabaseConnection,
+			expected: "5",
+		},
+		{
+			name:     "Custom default",
+			writer:   NewWriter(WithRetryAfter(time.Minute)),
+			err:      domainerror.ErrQuotaExceeded,
+			expected: "60",
+		},
+		{
+			name:     "Default disabled",
+			writer:   NewWriter(WithRetryAfter(0)),
+			err:      domainerror.ErrServiceUnavailable,
+			expected: "",
+		},
+		{
+			name:     "Other statuses",
+			writer:   NewWriter(),
+			err:      domainerror.ErrExternalServiceTimeout.WithRetryAfter(time.Second),
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.writer.Write(rec, httptest.NewRequest(http.MethodGet, "/reports", nil), tt.err)
+
+			if got := rec.Header().Get("Retry-After"); got != tt.expected {
+				t.Errorf("Retry-After = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
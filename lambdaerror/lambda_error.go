@@ -0,0 +1,86 @@
+package lambdaerror
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/renatofagalde/module-error/httperror"
+)
+
+// Responder converte erros em respostas de API Gateway e ALB, com o mesmo
+// mapeamento de status, o mesmo corpo JSON e o mesmo Retry-After (sugestão
+// padrão httperror.DefaultRetryAfter) do httperror
+type Responder struct {
+	mapper httperror.HTTPStatusMapper
+}
+
+var defaultResponder = NewResponder(httperror.NewDefaultHTTPStatusMapper())
+
+// NewResponder cria um Responder com o mapper de status informado
+func NewResponder(mapper httperror.HTTPStatusMapper) *Responder {
+	return &Responder{mapper: mapper}
+}
+
+// APIGatewayProxyResponse converte o erro em resposta de API Gateway REST (v1)
+func (r *Responder) APIGatewayProxyResponse(err error) events.APIGatewayProxyResponse {
+	status, headers, body := r.build(err)
+	return events.APIGatewayProxyResponse{
+		StatusCode: status,
+		Headers:    headers,
+		Body:       body,
+	}
+}
+
+// APIGatewayV2HTTPResponse converte o erro em resposta de API Gateway HTTP (v2)
+func (r *Responder) APIGatewayV2HTTPResponse(err error) events.APIGatewayV2HTTPResponse {
+	status, headers, body := r.build(err)
+	return events.APIGatewayV2HTTPResponse{
+		StatusCode: status,
+		Headers:    headers,
+		Body:       body,
+	}
+}
+
+// ALBTargetGroupResponse converte o erro em resposta de target group do ALB
+func (r *Responder) ALBTargetGroupResponse(err error) events.ALBTargetGroupResponse {
+	status, headers, body := r.build(err)
+	return events.ALBTargetGroupResponse{
+		StatusCode:        status,
+		StatusDescription: fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		Headers:           headers,
+		Body:              body,
+	}
+}
+
+func (r *Responder) build(err error) (int, map[string]string, string) {
+	status := r.mapper.Status(err)
+	headers := map[string]string{
+		"Content-Type": "application/json; charset=utf-8",
+	}
+	if retryAfter := httperror.RetryAfterHeader(err, status, httperror.DefaultRetryAfter); retryAfter != "" {
+		headers["Retry-After"] = retryAfter
+	}
+
+	body, marshalErr := json.Marshal(httperror.DefaultBody(err, status))
+	if marshalErr != nil {
+		return status, headers, ""
+	}
+	return status, headers, string(body)
+}
+
+// APIGatewayProxyResponse converte o erro com o Responder padrão
+func APIGatewayProxyResponse(err error) events.APIGatewayProxyResponse {
+	return defaultResponder.APIGatewayProxyResponse(err)
+}
+
+// APIGatewayV2HTTPResponse converte o erro com o Responder padrão
+func APIGatewayV2HTTPResponse(err error) events.APIGatewayV2HTTPResponse {
+	return defaultResponder.APIGatewayV2HTTPResponse(err)
+}
+
+// ALBTargetGroupResponse converte o erro com o Responder padrão
+func ALBTargetGroupResponse(err error) events.ALBTargetGroupResponse {
+	return defaultResponder.ALBTargetGroupResponse(err)
+}
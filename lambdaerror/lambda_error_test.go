@@ -0,0 +1,136 @@
+package lambdaerror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/jackc/pgx/v5/pgconn"
+	domainerror "github.com/renatofagalde/module-error"
+	"github.com/renatofagalde/module-error/dberror"
+	"github.com/renatofagalde/module-error/httperror"
+)
+
+func TestAPIGatewayProxyResponse(t *testing.T) {
+	resp := APIGatewayProxyResponse(domainerror.ErrCompanySuspended)
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("StatusCode = %v, want %v", resp.StatusCode, http.StatusForbidden)
+	}
+
+	if resp.Headers["Content-Type"] != "application/json; charset=utf-8" {
+		t.Errorf("Content-Type = %v, want application/json; charset=utf-8", resp.Headers["Content-Type"])
+	}
+
+	expected := `{"code":"COMPANY_SUSPENDED","message":"Empresa suspensa por inadimplência"}`
+	if resp.Body != expected {
+		t.Errorf("Body = %v, want %v", resp.Body, expected)
+	}
+}
+
+func TestResponder_RetryAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{name: "Default hint on 429", err: domainerror.ErrRateLimitExceeded, expected: "5"},
+		{name: "Error hint on 503", err: domainerror.ErrServiceUnavailable.WithRetryAfter(30 * time.Second), expected: "30"},
+		{name: "No header on 404", err: domainerror.ErrNotFound, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := APIGatewayProxyResponse(tt.err)
+
+			if got := resp.Headers["Retry-After"]; got != tt.expected {
+				t.Errorf("Retry-After = %q, want %q", got, tt.expected)
+			}
+
+			rec := httptest.NewRecorder()
+			httperror.Write(rec, nil, tt.err)
+			if got := rec.Header().Get("Retry-After"); got != resp.Headers["Retry-After"] {
+				t.Errorf("Retry-After = %q, httperror sends %q", resp.Headers["Retry-After"], got)
+			}
+		})
+	}
+}
+
+func TestAPIGatewayV2HTTPResponse(t *testing.T) {
+	resp := APIGatewayV2HTTPResponse(errors.New("secret"))
+
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("StatusCode = %v, want %v", resp.StatusCode, http.StatusInternalServerError)
+	}
+
+	expected := `{"code":"INTERNAL_SERVER_ERROR","message":"Erro interno do servidor"}`
+	if resp.Body != expected {
+		t.Errorf("Body = %v, want %v", resp.Body, expected)
+	}
+}
+
+func TestALBTargetGroupResponse(t *testing.T) {
+	resp := ALBTargetGroupResponse(domainerror.ErrNotFound)
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("StatusCode = %v, want %v", resp.StatusCode, http.StatusNotFound)
+	}
+
+	if resp.StatusDescription != "404 Not Found" {
+		t.Errorf("StatusDescription = %v, want 404 Not Found", resp.StatusDescription)
+	}
+}
+
+func TestProcessSQSEvent(t *testing.T) {
+	event := events.SQSEvent{Records: []events.SQSMessage{
+		{MessageId: "ok"},
+		{MessageId: "invalid"},
+		{MessageId: "db-down"},
+		{MessageId: "rate-limited"},
+		{MessageId: "query-failed"},
+		{MessageId: "deadlock"},
+		{MessageId: "db-timeout"},
+		{MessageId: "deadline"},
+		{MessageId: "locked"},
+		{MessageId: "internal"},
+		{MessageId: "unknown"},
+	}}
+
+	results := map[string]error{
+		"ok":           nil,
+		"invalid":      domainerror.ErrInvalidCPF,
+		"db-down":      domainerror.ErrDatabaseConnection,
+		"rate-limited": domainerror.ErrRateLimitExceeded,
+		"query-failed": domainerror.ErrDatabaseQuery,
+		"deadlock":     domainerror.Wrap(domainerror.ErrDeadlockDetected, errors.New("40P01")),
+		"db-timeout":   dberror.NewPostgresErrorMapper(nil).Map(&pgconn.PgError{Code: "57014"}),
+		"deadline":     dberror.NewPostgresErrorMapper(nil).Map(fmt.Errorf("query: %w", context.DeadlineExceeded)),
+		"locked":       domainerror.ErrRecordLocked,
+		"internal":     domainerror.ErrInternalServer,
+		"unknown":      errors.New("boom"),
+	}
+
+	resp := ProcessSQSEvent(event, func(message events.SQSMessage) error {
+		return results[message.MessageId]
+	})
+
+	var got []string
+	for _, failure := range resp.BatchItemFailures {
+		got = append(got, failure.ItemIdentifier)
+	}
+
+	expected := []string{"db-down", "rate-limited", "deadlock", "db-timeout", "deadline", "locked", "internal", "unknown"}
+	if len(got) != len(expected) {
+		t.Fatalf("BatchItemFailures = %v, want %v", got, expected)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("BatchItemFailures[%d] = %v, want %v", i, got[i], expected[i])
+		}
+	}
+}
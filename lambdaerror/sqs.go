@@ -0,0 +1,58 @@
+package lambdaerror
+
+import (
+	"errors"
+
+	"github.com/aws/aws-lambda-go/events"
+	domainerror "github.com/renatofagalde/module-error"
+)
+
+// SQSBatch acumula o resultado de cada mensagem de um lote SQS. Apenas erros
+// temporários (repetíveis ou não, ex: timeout do banco) viram batch item
+// failures e voltam para a fila; erros definitivos (ex: validação) são
+// descartados para não serem reprocessados em loop
+type SQSBatch struct {
+	failures []events.SQSBatchItemFailure
+}
+
+// NewSQSBatch cria um lote vazio
+func NewSQSBatch() *SQSBatch {
+	return &SQSBatch{}
+}
+
+// Record registra o resultado do processamento da mensagem
+func (b *SQSBatch) Record(messageID string, err error) {
+	if err == nil || !retryable(err) {
+		return
+	}
+	b.failures = append(b.failures, events.SQSBatchItemFailure{ItemIdentifier: messageID})
+}
+
+// Response retorna a resposta com as mensagens que devem ser reprocessadas
+func (b *SQSBatch) Response() events.SQSEventResponse {
+	failures := make([]events.SQSBatchItemFailure, len(b.failures))
+	copy(failures, b.failures)
+	return events.SQSEventResponse{BatchItemFailures: failures}
+}
+
+// retryable segue domainerror.IsTransient para erros de domínio: a mensagem
+// será reprocessada depois, então basta a causa ser temporária. Erros
+// desconhecidos, e ErrInternalServer que os representa, também voltam para a
+// fila, já que não se sabe se são definitivos
+func retryable(err error) bool {
+	var domainErr *domainerror.DomainError
+	if !errors.As(err, &domainErr) || errors.Is(domainErr, domainerror.ErrInternalServer) {
+		return true
+	}
+	return domainerror.IsTransient(domainErr)
+}
+
+// ProcessSQSEvent processa cada mensagem do evento com fn e monta a resposta
+// de batch item failures
+func ProcessSQSEvent(event events.SQSEvent, fn func(events.SQSMessage) error) events.SQSEventResponse {
+	batch := NewSQSBatch()
+	for _, message := range event.Records {
+		batch.Record(message.MessageId, fn(message))
+	}
+	return batch.Response()
+}
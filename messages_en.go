@@ -0,0 +1,121 @@
+package domainerror
+
+// messagesEN é o catálogo em inglês dos erros deste módulo
+var messagesEN = map[string]string{
+	// Validação e Input
+	"INVALID_INPUT":    "Invalid input",
+	"INVALID_EMAIL":    "Invalid email",
+	"INVALID_CPF":      "Invalid CPF",
+	"INVALID_CNPJ":     "Invalid CNPJ",
+	"INVALID_PHONE":    "Invalid phone number",
+	"INVALID_DATE":     "Invalid date",
+	"INVALID_CURRENCY": "Invalid monetary amount",
+	"REQUIRED_FIELD":   "Required field is missing",
+
+	// Registro/Recurso
+	"NOT_FOUND":       "Record not found",
+	"CONFLICT":        "Record already exists",
+	"DUPLICATE_EMAIL": "Email already registered",
+	"DUPLICATE_CPF":   "CPF already registered",
+	"DUPLICATE_CNPJ":  "CNPJ already registered",
+	"RECORD_LOCKED":   "Record is locked for editing",
+	"RECORD_IN_USE":   "Record is in use and cannot be deleted",
+
+	// Autenticação e Autorização
+	"UNAUTHORIZED":             "Unauthorized",
+	"FORBIDDEN":                "Access denied",
+	"INVALID_CREDENTIALS":      "Invalid credentials",
+	"SESSION_EXPIRED":          "Session expired",
+	"TOKEN_INVALID":            "Invalid token",
+	"TOKEN_EXPIRED":            "Token expired",
+	"INSUFFICIENT_PERMISSIONS": "Insufficient permissions",
+
+	// Negócio - Financeiro
+	"INSUFFICIENT_BALANCE":  "Insufficient balance",
+	"PAYMENT_OVERDUE":       "Payment overdue",
+	"PAYMENT_FAILED":        "Payment failed",
+	"INVOICE_NOT_PAID":      "Invoice not paid",
+	"CREDIT_LIMIT_EXCEEDED": "Credit limit exceeded",
+
+	// Estado/Status
+	"INVALID_STATUS":    "Invalid status for this operation",
+	"STATUS_CONFLICT":   "Status conflict",
+	"ACCOUNT_SUSPENDED": "Account suspended",
+	"ACCOUNT_INACTIVE":  "Account inactive",
+	"COMPANY_SUSPENDED": "Company suspended due to overdue payments",
+
+	// Idempotência e Concorrência
+	"DUPLICATE_REQUEST":       "Duplicate request",
+	"IDEMPOTENCY_KEY_USED":    "Idempotency key already used",
+	"IDEMPOTENCY_CONFLICT":    "Idempotency conflict - different operation with the same key",
+	"CONCURRENT_MODIFICATION": "Record was modified by another user",
+	"OPTIMISTIC_LOCK_FAILED":  "Optimistic concurrency check failed",
+	"SERIALIZATION_FAILURE":   "Transaction serialization failure",
+	"DEADLOCK_DETECTED":       "Deadlock detected in transaction",
+	"LOCK_WAIT_TIMEOUT":       "Lock wait timeout exceeded",
+
+	// Limite e Rate Limiting
+	"RATE_LIMIT_EXCEEDED":   "Rate limit exceeded",
+	"QUOTA_EXCEEDED":        "Quota exceeded",
+	"MAX_ATTEMPTS_EXCEEDED": "Maximum number of attempts exceeded",
+
+	// Integração Externa
+	"EXTERNAL_SERVICE_UNAVAILABLE": "External service unavailable",
+	"EXTERNAL_SERVICE_TIMEOUT":     "External service timed out",
+	"THIRD_PARTY_API_ERROR":        "Third-party API error",
+
+	// Relacionamento/Dependência
+	"ORPHAN_RECORD":        "Orphan record - required relationship is missing",
+	"CIRCULAR_REFERENCE":   "Circular reference detected",
+	"INVALID_RELATIONSHIP": "Invalid relationship",
+	"DEPENDENCY_EXISTS":    "Cannot delete - dependent records exist",
+
+	// CRM Específicos
+	"LEAD_ALREADY_CONVERTED": "Lead already converted into a customer",
+	"INVALID_LEAD_STATUS":    "Lead status does not allow this operation",
+	"DUPLICATE_LEAD":         "Duplicate lead",
+	"CUSTOMER_NOT_ACTIVE":    "Customer is not active",
+	"CONTRACT_EXPIRED":       "Contract expired",
+	"CONTRACT_NOT_ACTIVE":    "Contract is not active",
+	"MODULE_NOT_CONTRACTED":  "Module not contracted by the company",
+
+	// Arquivo/Upload
+	"FILE_TOO_LARGE":     "File too large",
+	"INVALID_FILE_TYPE":  "Invalid file type",
+	"FILE_UPLOAD_FAILED": "File upload failed",
+	"FILE_NOT_FOUND":     "File not found",
+
+	// Protocolo HTTP
+	"METHOD_NOT_ALLOWED":     "HTTP method not allowed",
+	"NOT_ACCEPTABLE":         "Response format not supported",
+	"REQUEST_TIMEOUT":        "Request timed out",
+	"UNSUPPORTED_MEDIA_TYPE": "Unsupported media type",
+	"EXPECTATION_FAILED":     "Expectation failed",
+
+	// Precondição e Versionamento
+	"PRECONDITION_FAILED": "Precondition failed",
+	"ETAG_MISMATCH":       "ETag does not match - resource was modified",
+
+	// Remoção e Arquivamento
+	"RESOURCE_GONE":     "Resource was permanently removed",
+	"RESOURCE_ARCHIVED": "Resource was archived",
+
+	// Dependência e Compliance
+	"FAILED_DEPENDENCY":             "Required dependency failed",
+	"UNAVAILABLE_FOR_LEGAL_REASONS": "Unavailable for legal reasons",
+
+	// Sistema
+	"INTERNAL_SERVER_ERROR":     "Internal server error",
+	"DATABASE_CONNECTION_ERROR": "Database connection error",
+	"DATABASE_QUERY_ERROR":      "Database query error",
+	"DATABASE_TIMEOUT":          "Database query timed out",
+	"SERVICE_UNAVAILABLE":       "Service temporarily unavailable",
+}
+
+// templatesEN são os templates em inglês das mensagens com placeholders
+var templatesEN = map[string]string{
+	"REQUIRED_FIELD":        "Field {field} is required",
+	"FILE_TOO_LARGE":        "File too large: maximum {max} MB",
+	"INVALID_FILE_TYPE":     "Invalid file type: {type}",
+	"MAX_ATTEMPTS_EXCEEDED": "Maximum number of attempts ({max}) exceeded",
+}
@@ -0,0 +1,121 @@
+package domainerror
+
+// messagesES é o catálogo em espanhol dos erros deste módulo
+var messagesES = map[string]string{
+	// Validação e Input
+	"INVALID_INPUT":    "Entrada inválida",
+	"INVALID_EMAIL":    "Email inválido",
+	"INVALID_CPF":      "CPF inválido",
+	"INVALID_CNPJ":     "CNPJ inválido",
+	"INVALID_PHONE":    "Teléfono inválido",
+	"INVALID_DATE":     "Fecha inválida",
+	"INVALID_CURRENCY": "Valor monetario inválido",
+	"REQUIRED_FIELD":   "Campo obligatorio no informado",
+
+	// Registro/Recurso
+	"NOT_FOUND":       "Registro no encontrado",
+	"CONFLICT":        "El registro ya existe",
+	"DUPLICATE_EMAIL": "Email ya registrado",
+	"DUPLICATE_CPF":   "CPF ya registrado",
+	"DUPLICATE_CNPJ":  "CNPJ ya registrado",
+	"RECORD_LOCKED":   "Registro bloqueado para edición",
+	"RECORD_IN_USE":   "Registro en uso, no se puede eliminar",
+
+	// Autenticação e Autorização
+	"UNAUTHORIZED":             "No autorizado",
+	"FORBIDDEN":                "Acceso denegado",
+	"INVALID_CREDENTIALS":      "Credenciales inválidas",
+	"SESSION_EXPIRED":          "Sesión expirada",
+	"TOKEN_INVALID":            "Token inválido",
+	"TOKEN_EXPIRED":            "Token expirado",
+	"INSUFFICIENT_PERMISSIONS": "Permisos insuficientes",
+
+	// Negócio - Financeiro
+	"INSUFFICIENT_BALANCE":  "Saldo insuficiente",
+	"PAYMENT_OVERDUE":       "Pago atrasado",
+	"PAYMENT_FAILED":        "Falla en el pago",
+	"INVOICE_NOT_PAID":      "Factura no pagada",
+	"CREDIT_LIMIT_EXCEEDED": "Límite de crédito excedido",
+
+	// Estado/Status
+	"INVALID_STATUS":    "Estado inválido para la operación",
+	"STATUS_CONFLICT":   "Conflicto de estado",
+	"ACCOUNT_SUSPENDED": "Cuenta suspendida",
+	"ACCOUNT_INACTIVE":  "Cuenta inactiva",
+	"COMPANY_SUSPENDED": "Empresa suspendida por falta de pago",
+
+	// Idempotência e Concorrência
+	"DUPLICATE_REQUEST":       "Solicitud duplicada",
+	"IDEMPOTENCY_KEY_USED":    "Clave de idempotencia ya utilizada",
+	"IDEMPOTENCY_CONFLICT":    "Conflicto de idempotencia - operación diferente con la misma clave",
+	"CONCURRENT_MODIFICATION": "Registro modificado por otro usuario",
+	"OPTIMISTIC_LOCK_FAILED":  "Falla en el control de concurrencia optimista",
+	"SERIALIZATION_FAILURE":   "Falla de serialización de la transacción",
+	"DEADLOCK_DETECTED":       "Deadlock detectado en la transacción",
+	"LOCK_WAIT_TIMEOUT":       "Tiempo de espera de bloqueo agotado",
+
+	// Limite e Rate Limiting
+	"RATE_LIMIT_EXCEEDED":   "Límite de solicitudes excedido",
+	"QUOTA_EXCEEDED":        "Cuota excedida",
+	"MAX_ATTEMPTS_EXCEEDED": "Número máximo de intentos excedido",
+
+	// Integração Externa
+	"EXTERNAL_SERVICE_UNAVAILABLE": "Servicio externo no disponible",
+	"EXTERNAL_SERVICE_TIMEOUT":     "Tiempo de espera agotado en servicio externo",
+	"THIRD_PARTY_API_ERROR":        "Error en API de terceros",
+
+	// Relacionamento/Dependência
+	"ORPHAN_RECORD":        "Registro huérfano - falta una relación obligatoria",
+	"CIRCULAR_REFERENCE":   "Referencia circular detectada",
+	"INVALID_RELATIONSHIP": "Relación inválida",
+	"DEPENDENCY_EXISTS":    "No se puede eliminar - existen dependencias",
+
+	// CRM Específicos
+	"LEAD_ALREADY_CONVERTED": "Lead ya convertido en cliente",
+	"INVALID_LEAD_STATUS":    "El estado del lead no permite esta operación",
+	"DUPLICATE_LEAD":         "Lead duplicado",
+	"CUSTOMER_NOT_ACTIVE":    "El cliente no está activo",
+	"CONTRACT_EXPIRED":       "Contrato expirado",
+	"CONTRACT_NOT_ACTIVE":    "El contrato no está activo",
+	"MODULE_NOT_CONTRACTED":  "Módulo no contratado por la empresa",
+
+	// Arquivo/Upload
+	"FILE_TOO_LARGE":     "Archivo demasiado grande",
+	"INVALID_FILE_TYPE":  "Tipo de archivo inválido",
+	"FILE_UPLOAD_FAILED": "Falla en la carga del archivo",
+	"FILE_NOT_FOUND":     "Archivo no encontrado",
+
+	// Protocolo HTTP
+	"METHOD_NOT_ALLOWED":     "Método HTTP no permitido",
+	"NOT_ACCEPTABLE":         "Formato de respuesta no soportado",
+	"REQUEST_TIMEOUT":        "Tiempo de solicitud agotado",
+	"UNSUPPORTED_MEDIA_TYPE": "Tipo de medio no soportado",
+	"EXPECTATION_FAILED":     "Expectativa no cumplida",
+
+	// Precondição e Versionamento
+	"PRECONDITION_FAILED": "Falló la precondición",
+	"ETAG_MISMATCH":       "El ETag no coincide - recurso modificado",
+
+	// Remoção e Arquivamento
+	"RESOURCE_GONE":     "El recurso fue eliminado permanentemente",
+	"RESOURCE_ARCHIVED": "El recurso fue archivado",
+
+	// Dependência e Compliance
+	"FAILED_DEPENDENCY":             "Falla en una dependencia necesaria",
+	"UNAVAILABLE_FOR_LEGAL_REASONS": "No disponible por razones legales",
+
+	// Sistema
+	"INTERNAL_SERVER_ERROR":     "Error interno del servidor",
+	"DATABASE_CONNECTION_ERROR": "Error de conexión con la base de datos",
+	"DATABASE_QUERY_ERROR":      "Error en la ejecución de la consulta",
+	"DATABASE_TIMEOUT":          "Tiempo de ejecución de la consulta agotado",
+	"SERVICE_UNAVAILABLE":       "Servicio temporalmente no disponible",
+}
+
+// templatesES são os templates em espanhol das mensagens com placeholders
+var templatesES = map[string]string{
+	"REQUIRED_FIELD":        "El campo {field} es obligatorio",
+	"FILE_TOO_LARGE":        "Archivo demasiado grande: máximo {max} MB",
+	"INVALID_FILE_TYPE":     "Tipo de archivo inválido: {type}",
+	"MAX_ATTEMPTS_EXCEEDED": "Número máximo de intentos ({max}) excedido",
+}
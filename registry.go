@@ -0,0 +1,99 @@
+package domainerror
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Registry é o catálogo de erros de domínio indexado por código. Os erros
+// Err* deste módulo vivem no registry padrão; consumidores registram os
+// próprios códigos nele via Register
+type Registry struct {
+	mu     sync.RWMutex
+	byCode map[string]*DomainError
+	order  []*DomainError
+}
+
+var defaultRegistry = NewRegistry()
+
+// NewRegistry cria um registry vazio
+func NewRegistry() *Registry {
+	return &Registry{
+		byCode: make(map[string]*DomainError),
+	}
+}
+
+// Register adiciona o erro ao registry, retornando erro se o código já existir
+func (r *Registry) Register(err *DomainError) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.byCode[err.code]; exists {
+		return fmt.Errorf("domainerror: código %q já registrado", err.code)
+	}
+
+	r.byCode[err.code] = err
+	r.order = append(r.order, err)
+	return nil
+}
+
+// MustRegister adiciona o erro ao registry e entra em pânico se o código já
+// existir, para que códigos duplicados sejam detectados na inicialização
+func (r *Registry) MustRegister(err *DomainError) *DomainError {
+	if regErr := r.Register(err); regErr != nil {
+		panic(regErr)
+	}
+	return err
+}
+
+// Lookup retorna o erro registrado com o código informado
+func (r *Registry) Lookup(code string) (*DomainError, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	err, ok := r.byCode[code]
+	return err, ok
+}
+
+// All retorna os erros registrados na ordem de registro
+func (r *Registry) All() []*DomainError {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	all := make([]*DomainError, len(r.order))
+	copy(all, r.order)
+	return all
+}
+
+// Unmapped retorna os códigos registrados que não possuem status HTTP, nem
+// próprio nem herdado
+func (r *Registry) Unmapped() []string {
+	var codes []string
+	for _, err := range r.All() {
+		if err.HTTPStatus() == 0 {
+			codes = append(codes, err.code)
+		}
+	}
+	return codes
+}
+
+// Register adiciona o erro ao registry padrão, onde vivem os erros Err* deste
+// módulo. Entra em pânico se o código já estiver registrado
+func Register(err *DomainError) *DomainError {
+	return defaultRegistry.MustRegister(err)
+}
+
+// Lookup retorna o erro com o código informado no registry padrão
+func Lookup(code string) (*DomainError, bool) {
+	return defaultRegistry.Lookup(code)
+}
+
+// All retorna todos os erros do registry padrão na ordem de registro
+func All() []*DomainError {
+	return defaultRegistry.All()
+}
+
+// Unmapped retorna os códigos do registry padrão que não possuem status HTTP
+func Unmapped() []string {
+	return defaultRegistry.Unmapped()
+}
@@ -0,0 +1,64 @@
+package domainerror
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRegistry_Register(t *testing.T) {
+	registry := NewRegistry()
+	contract := NewWithStatus("DUPLICATE_CONTRACT", "Contrato duplicado", http.StatusConflict)
+
+	if err := registry.Register(contract); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	if err := registry.Register(New("DUPLICATE_CONTRACT", "Outro contrato")); err == nil {
+		t.Errorf("Register() with duplicated code error = nil, want error")
+	}
+
+	got, ok := registry.Lookup("DUPLICATE_CONTRACT")
+	if !ok || got != contract {
+		t.Errorf("Lookup() = %v, %v, want %v, true", got, ok, contract)
+	}
+
+	if _, ok := registry.Lookup("UNKNOWN"); ok {
+		t.Errorf("Lookup(UNKNOWN) ok = true, want false")
+	}
+}
+
+func TestRegistry_MustRegisterPanicsOnDuplicate(t *testing.T) {
+	registry := NewRegistry()
+	registry.MustRegister(New("CONTRACT_LOCKED", "Contrato bloqueado"))
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustRegister() with duplicated code did not panic")
+		}
+	}()
+
+	registry.MustRegister(New("CONTRACT_LOCKED", "Contrato bloqueado"))
+}
+
+func TestRegistry_AllAndUnmapped(t *testing.T) {
+	registry := NewRegistry()
+	registry.MustRegister(NewWithStatus("A", "a", http.StatusBadRequest))
+	registry.MustRegister(New("B", "b"))
+
+	all := registry.All()
+	if len(all) != 2 || all[0].Code() != "A" || all[1].Code() != "B" {
+		t.Errorf("All() = %v, want [A B]", all)
+	}
+
+	unmapped := registry.Unmapped()
+	if len(unmapped) != 1 || unmapped[0] != "B" {
+		t.Errorf("Unmapped() = %v, want [B]", unmapped)
+	}
+}
+
+func TestLookup_BuiltInErrors(t *testing.T) {
+	got, ok := Lookup("NOT_FOUND")
+	if !ok || got != ErrNotFound {
+		t.Errorf("Lookup(NOT_FOUND) = %v, %v, want ErrNotFound, true", got, ok)
+	}
+}
@@ -0,0 +1,35 @@
+package domainerror
+
+import (
+	"errors"
+	"time"
+)
+
+// IsRetryable indica se a operação que falhou com err pode ser repetida. Erros
+// que não são de domínio retornam false: quem decide é o chamador
+func IsRetryable(err error) bool {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr.Retryable()
+	}
+	return false
+}
+
+// IsTransient indica se err foi causado por uma condição temporária
+func IsTransient(err error) bool {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr.Transient()
+	}
+	return false
+}
+
+// RetryAfter retorna o tempo sugerido antes de repetir a operação que falhou
+// com err; 0 quando não há sugestão
+func RetryAfter(err error) time.Duration {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr.RetryAfter()
+	}
+	return 0
+}
@@ -0,0 +1,73 @@
+package domainerror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name              string
+		err               error
+		expectedRetryable bool
+		expectedTransient bool
+	}{
+		{name: "Database connection", err: ErrDatabaseConnection, expectedRetryable: true, expectedTransient: true},
+		{name: "External service timeout", err: ErrExternalServiceTimeout, expectedRetryable: true, expectedTransient: true},
+		{name: "Rate limit", err: ErrRateLimitExceeded, expectedRetryable: true, expectedTransient: true},
+		{name: "Serialization failure", err: ErrSerializationFailure, expectedRetryable: true, expectedTransient: true},
+		{name: "Deadlock", err: ErrDeadlockDetected, expectedRetryable: true, expectedTransient: true},
+		{name: "Lock wait timeout", err: ErrLockWaitTimeout, expectedRetryable: true, expectedTransient: true},
+		{name: "Wrapped with cause", err: fmt.Errorf("save: %w", Wrap(ErrDatabaseConnection, errors.New("dial tcp"))), expectedRetryable: true, expectedTransient: true},
+		{name: "Request timeout is transient only", err: ErrRequestTimeout, expectedRetryable: false, expectedTransient: true},
+		{name: "Database timeout is transient only", err: ErrDatabaseTimeout, expectedRetryable: false, expectedTransient: true},
+		{name: "Validation", err: ErrInvalidCPF, expectedRetryable: false, expectedTransient: false},
+		{name: "Validation errors", err: NewValidationErrors().Add("/name", "REQUIRED_FIELD", "obrigatório", nil), expectedRetryable: false, expectedTransient: false},
+		{name: "Concurrent modification", err: ErrConcurrentModification, expectedRetryable: false, expectedTransient: false},
+		{name: "Record locked for editing is transient only", err: ErrRecordLocked, expectedRetryable: false, expectedTransient: true},
+		{name: "Non domain error", err: errors.New("boom"), expectedRetryable: false, expectedTransient: false},
+		{name: "Nil", err: nil, expectedRetryable: false, expectedTransient: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.expectedRetryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.expectedRetryable)
+			}
+			if got := IsTransient(tt.err); got != tt.expectedTransient {
+				t.Errorf("IsTransient() = %v, want %v", got, tt.expectedTransient)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	err := fmt.Errorf("export: %w", ErrRateLimitExceeded.WithRetryAfter(30*time.Second))
+
+	if got := RetryAfter(err); got != 30*time.Second {
+		t.Errorf("RetryAfter() = %v, want %v", got, 30*time.Second)
+	}
+
+	if got := RetryAfter(ErrRateLimitExceeded); got != 0 {
+		t.Errorf("RetryAfter(sentinel) = %v, want 0", got)
+	}
+}
+
+func TestDomainError_RetryBuilders(t *testing.T) {
+	partnerDown := NewWithCategory("PARTNER_DOWN", "Parceiro fora do ar", CategoryExternal).WithRetryable(true)
+	partnerTimeout := partnerDown.Child("PARTNER_TIMEOUT", "Parceiro não respondeu")
+
+	if !partnerTimeout.Retryable() {
+		t.Errorf("Child().Retryable() = false, want true")
+	}
+
+	if partnerTimeout.WithRetryable(false).Retryable() {
+		t.Errorf("WithRetryable(false).Retryable() = true, want false")
+	}
+
+	if !partnerTimeout.Retryable() {
+		t.Errorf("WithRetryable() changed the original error")
+	}
+}
@@ -0,0 +1,54 @@
+package domainerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// renderTemplate substitui os placeholders {nome} pelos valores de lookup.
+// Chaves inválidas (ex: "{ }") são mantidas como texto. Retorna false se algum
+// placeholder não tiver valor, para que o chamador use a mensagem sem template
+func renderTemplate(template string, lookup func(key string) (any, bool)) (string, bool) {
+	var b strings.Builder
+	rest := template
+	for {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			b.WriteString(rest)
+			return b.String(), true
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			b.WriteString(rest)
+			return b.String(), true
+		}
+		end += start
+
+		key := rest[start+1 : end]
+		if !isPlaceholderKey(key) {
+			b.WriteString(rest[:end+1])
+			rest = rest[end+1:]
+			continue
+		}
+
+		value, ok := lookup(key)
+		if !ok {
+			return "", false
+		}
+		b.WriteString(rest[:start])
+		b.WriteString(fmt.Sprint(value))
+		rest = rest[end+1:]
+	}
+}
+
+func isPlaceholderKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		if !(r == '_' || r == '.' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+package domainerror
+
+import (
+	"testing"
+)
+
+func TestDomainError_MessageTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *DomainError
+		expected string
+	}{
+		{
+			name:     "Field placeholder",
+			err:      ErrRequiredField.WithField("email"),
+			expected: "Campo email obrigatório",
+		},
+		{
+			name:     "Param placeholder",
+			err:      ErrFileTooLarge.WithParam("max", 10),
+			expected: "Arquivo muito grande: máximo 10 MB",
+		},
+		{
+			name:     "Details fill placeholders",
+			err:      ErrMaxAttemptsExceeded.WithDetails(map[string]any{"max": 3}),
+			expected: "Número máximo de tentativas (3) excedido",
+		},
+		{
+			name:     "Params win over details",
+			err:      ErrFileTooLarge.WithDetails(map[string]any{"max": 5}).WithParam("max", 20),
+			expected: "Arquivo muito grande: máximo 20 MB",
+		},
+		{
+			name:     "Missing param falls back to plain message",
+			err:      ErrRequiredField,
+			expected: "Campo obrigatório não informado",
+		},
+		{
+			name:     "WithMessage drops the template",
+			err:      ErrRequiredField.WithField("email").WithMessage("Informe o email"),
+			expected: "Informe o email",
+		},
+		{
+			name:     "Consumer template",
+			err:      New("CONTRACT_LIMIT", "Limite de contratos atingido").WithTemplate("Limite de {limit} contratos atingido").WithParam("limit", 5),
+			expected: "Limite de 5 contratos atingido",
+		},
+		{
+			name:     "Invalid keys are kept as text",
+			err:      New("JSON_EXAMPLE", "Exemplo").WithTemplate("Use { } ou {a b} no corpo {name}").WithParam("name", "x"),
+			expected: "Use { } ou {a b} no corpo x",
+		},
+		{
+			name:     "Unclosed brace",
+			err:      New("UNCLOSED", "Exemplo").WithTemplate("Valor {max"),
+			expected: "Valor {max",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Message(); got != tt.expected {
+				t.Errorf("Message() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDomainError_TemplateInError(t *testing.T) {
+	err := ErrRequiredField.WithField("email")
+
+	expected := "REQUIRED_FIELD: Campo email obrigatório"
+	if got := err.Error(); got != expected {
+		t.Errorf("Error() = %v, want %v", got, expected)
+	}
+
+	if ErrRequiredField.Message() != "Campo obrigatório não informado" {
+		t.Errorf("WithField() changed the sentinel message")
+	}
+}
+
+func TestDomainError_Title(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *DomainError
+		expected string
+	}{
+		{name: "Sentinel", err: ErrNotFound, expected: "Registro não encontrado"},
+		{name: "Rendered template", err: ErrRequiredField.WithField("email"), expected: "Campo obrigatório não informado"},
+		{name: "Custom message", err: ErrNotFound.WithMessage("Cliente 42 não existe"), expected: "Registro não encontrado"},
+		{name: "Localized", err: LocalizeError(ErrRequiredField.WithField("email"), LocaleES).(*DomainError), expected: "Campo obligatorio no informado"},
+		{name: "Unregistered code", err: New("CONTRACT_LIMIT", "Limite de contratos atingido"), expected: "Limite de contratos atingido"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Title(); got != tt.expected {
+				t.Errorf("Title() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalize_Templates(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		locale   string
+		expected string
+	}{
+		{name: "English field", err: ErrRequiredField.WithField("email"), locale: LocaleEN, expected: "Field email is required"},
+		{name: "Spanish param", err: ErrFileTooLarge.WithParam("max", 10), locale: LocaleES, expected: "Archivo demasiado grande: máximo 10 MB"},
+		{name: "English missing param", err: ErrFileTooLarge, locale: LocaleEN, expected: "File too large"},
+		{name: "Portuguese", err: ErrInvalidFileType.WithParam("type", "exe"), locale: LocalePtBR, expected: "Tipo de arquivo inválido: exe"},
+		{name: "Custom template is kept", err: ErrFileTooLarge.WithTemplate("Máximo de {max} MB").WithParam("max", 1), locale: LocaleEN, expected: "Máximo de 1 MB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Localize(tt.err, tt.locale); got != tt.expected {
+				t.Errorf("Localize() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalizeError_ViolationTemplates(t *testing.T) {
+	verrs := NewValidationErrors().
+		AddError("/attachment", ErrFileTooLarge.WithParam("max", 10)).
+		AddError("/email", ErrRequiredField.WithField("email"))
+
+	localized := LocalizeError(verrs, LocaleEN).(*ValidationErrors)
+	violations := localized.Violations()
+
+	expected := []string{"File too large: maximum 10 MB", "Field email is required"}
+	if len(violations) != len(expected) {
+		t.Fatalf("Violations() = %v, want %v", violations, expected)
+	}
+	for i := range expected {
+		if violations[i].Message != expected[i] {
+			t.Errorf("Violations()[%d].Message = %v, want %v", i, violations[i].Message, expected[i])
+		}
+	}
+
+	if violations[0].Params["max"] != 10 || violations[1].Params["field"] != "email" {
+		t.Errorf("Params = %v and %v, want max=10 and field=email", violations[0].Params, violations[1].Params)
+	}
+
+	if verrs.Violations()[1].Message != "Campo email obrigatório" {
+		t.Errorf("LocalizeError() changed the original violation: %v", verrs.Violations()[1])
+	}
+}
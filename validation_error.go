@@ -0,0 +1,139 @@
+package domainerror
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Violation descreve um campo inválido. Path segue o formato JSON Pointer
+// (RFC 6901), ex: /customer/document/cpf
+type Violation struct {
+	Path    string         `json:"path"`
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Params  map[string]any `json:"params,omitempty"`
+}
+
+// ValidationErrors agrega várias violações de campo em um único erro.
+// É compatível com errors.Is(err, ErrInvalidInput)
+type ValidationErrors struct {
+	violations   []Violation
+	invalidInput *DomainError
+}
+
+// NewValidationErrors cria um agregador de violações vazio
+func NewValidationErrors() *ValidationErrors {
+	return &ValidationErrors{}
+}
+
+// Add registra uma violação para o campo indicado por path
+func (v *ValidationErrors) Add(path, code, message string, params map[string]any) *ValidationErrors {
+	v.violations = append(v.violations, Violation{
+		Path:    path,
+		Code:    code,
+		Message: message,
+		Params:  params,
+	})
+	return v
+}
+
+// AddError registra uma violação a partir de um erro de domínio (ex: ErrInvalidCPF).
+// Params da violação reúne os details, o campo (em "field") e os params do erro,
+// para que a mensagem possa ser renderizada de novo em outro idioma
+func (v *ValidationErrors) AddError(path string, err *DomainError) *ValidationErrors {
+	params := err.Details()
+	set := func(k string, value any) {
+		if params == nil {
+			params = make(map[string]any, len(err.params)+1)
+		}
+		params[k] = value
+	}
+	if err.field != "" {
+		set("field", err.field)
+	}
+	for k, value := range err.params {
+		set(k, value)
+	}
+	return v.Add(path, err.Code(), err.Message(), params)
+}
+
+// WithCause retorna uma cópia das violações com a causa original anexada ao
+// ErrInvalidInput, acessível via errors.As (ex: o status de outro serviço)
+func (v *ValidationErrors) WithCause(cause error) *ValidationErrors {
+	return &ValidationErrors{
+		violations:   v.Violations(),
+		invalidInput: v.base().WithCause(cause),
+	}
+}
+
+// Violations retorna uma cópia das violações registradas
+func (v *ValidationErrors) Violations() []Violation {
+	violations := make([]Violation, len(v.violations))
+	copy(violations, v.violations)
+	return violations
+}
+
+// HasViolations indica se alguma violação foi registrada
+func (v *ValidationErrors) HasViolations() bool {
+	return len(v.violations) > 0
+}
+
+// Err retorna nil quando não há violações, evitando o nil tipado em
+// retornos do tipo error
+func (v *ValidationErrors) Err() error {
+	if !v.HasViolations() {
+		return nil
+	}
+	return v
+}
+
+func (v *ValidationErrors) Error() string {
+	parts := make([]string, 0, len(v.violations))
+	for _, violation := range v.violations {
+		parts = append(parts, violation.Path+": "+violation.Message)
+	}
+	message := v.base().Code() + ": " + v.base().Message() + ": " + strings.Join(parts, "; ")
+	if cause := v.base().Unwrap(); cause != nil {
+		message += ": " + cause.Error()
+	}
+	return message
+}
+
+// Unwrap expõe ErrInvalidInput para errors.Is / errors.As e para os mappers de status
+func (v *ValidationErrors) Unwrap() error {
+	return v.base()
+}
+
+// base retorna ErrInvalidInput ou a sua cópia localizada
+func (v *ValidationErrors) base() *DomainError {
+	if v.invalidInput == nil {
+		return ErrInvalidInput
+	}
+	return v.invalidInput
+}
+
+// MarshalJSON serializa o erro como ErrInvalidInput acompanhado do array violations
+func (v *ValidationErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code       string      `json:"code"`
+		Message    string      `json:"message"`
+		Violations []Violation `json:"violations"`
+	}{
+		Code:       v.base().Code(),
+		Message:    v.base().Message(),
+		Violations: v.Violations(),
+	})
+}
+
+// JSONPointer monta um JSON Pointer a partir dos segmentos informados,
+// escapando "~" e "/" conforme a RFC 6901
+func JSONPointer(tokens ...string) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		token = strings.ReplaceAll(token, "~", "~0")
+		token = strings.ReplaceAll(token, "/", "~1")
+		b.WriteString("/")
+		b.WriteString(token)
+	}
+	return b.String()
+}
@@ -0,0 +1,94 @@
+package domainerror
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestValidationErrors(t *testing.T) {
+	verrs := NewValidationErrors().
+		AddError("/document/cpf", ErrInvalidCPF).
+		Add("/name", ErrRequiredField.Code(), "Nome obrigatório", map[string]any{"min": 3})
+
+	var err error = verrs
+
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("errors.Is(err, ErrInvalidInput) = false, want true")
+	}
+
+	if errors.Is(err, ErrNotFound) {
+		t.Errorf("errors.Is(err, ErrNotFound) = true, want false")
+	}
+
+	expected := "INVALID_INPUT: Input inválido: /document/cpf: CPF inválido; /name: Nome obrigatório"
+	if got := err.Error(); got != expected {
+		t.Errorf("Error() = %v, want %v", got, expected)
+	}
+
+	body, marshalErr := json.Marshal(err)
+	if marshalErr != nil {
+		t.Fatalf("json.Marshal() error = %v", marshalErr)
+	}
+
+	expectedBody := `{"code":"INVALID_INPUT","message":"Input inválido","violations":[` +
+		`{"path":"/document/cpf","code":"INVALID_CPF","message":"CPF inválido"},` +
+		`{"path":"/name","code":"REQUIRED_FIELD","message":"Nome obrigatório","params":{"min":3}}]}`
+	if string(body) != expectedBody {
+		t.Errorf("json.Marshal() = %s, want %s", body, expectedBody)
+	}
+}
+
+func TestValidationErrors_Err(t *testing.T) {
+	verrs := NewValidationErrors()
+
+	if err := verrs.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+
+	verrs.AddError("/email", ErrInvalidEmail)
+
+	if err := verrs.Err(); err == nil {
+		t.Errorf("Err() = nil, want error")
+	}
+}
+
+func TestValidationErrors_WithCause(t *testing.T) {
+	cause := errors.New("upstream respondeu 400 Bad Request")
+	verrs := NewValidationErrors().AddError("/email", ErrInvalidEmail)
+
+	err := verrs.WithCause(cause)
+
+	if !errors.Is(err, ErrInvalidInput) || !errors.Is(err, cause) {
+		t.Errorf("WithCause() = %v, want ErrInvalidInput with cause", err)
+	}
+
+	expected := "INVALID_INPUT: Input inválido: /email: Email inválido: upstream respondeu 400 Bad Request"
+	if got := err.Error(); got != expected {
+		t.Errorf("Error() = %v, want %v", got, expected)
+	}
+
+	if len(err.Violations()) != 1 || errors.Is(verrs, cause) {
+		t.Errorf("WithCause() changed the original ValidationErrors")
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	tests := []struct {
+		name     string
+		tokens   []string
+		expected string
+	}{
+		{name: "Nested field", tokens: []string{"customer", "email"}, expected: "/customer/email"},
+		{name: "Array index", tokens: []string{"phones", "0"}, expected: "/phones/0"},
+		{name: "Escaped characters", tokens: []string{"a/b", "c~d"}, expected: "/a~1b/c~0d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := JSONPointer(tt.tokens...); got != tt.expected {
+				t.Errorf("JSONPointer() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}